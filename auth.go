@@ -0,0 +1,227 @@
+// Package client provides methods, values and urls for interacting with Yandex Tracker
+package client
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"resty.dev/v3"
+)
+
+const (
+	iamAuthScheme         = "Bearer"
+	defaultIAMTokenURL    = "https://iam.api.cloud.yandex.net/iam/v1/tokens"
+	defaultRefreshBefore  = 5 * time.Minute
+	serviceAccountJWTLife = time.Hour
+)
+
+// Token describes credentials sent in the Authorization header
+type Token struct {
+	// Authorization scheme: OAuth or Bearer.
+	Scheme string
+	// Token value.
+	Value string
+	// Expiration time. Zero value means the token does not expire.
+	Expiry time.Time
+}
+
+// TokenSource provides tokens for authorizing requests. Implementations must be safe for concurrent use.
+type TokenSource interface {
+	Token(ctx context.Context) (*Token, error)
+}
+
+type staticTokenSource struct {
+	token Token
+}
+
+func (s *staticTokenSource) Token(context.Context) (*Token, error) {
+	token := s.token
+	return &token, nil
+}
+
+// StaticOAuthToken returns TokenSource always providing the OAuth token
+func StaticOAuthToken(token string) TokenSource {
+	return &staticTokenSource{token: Token{Scheme: defaultAuthScheme, Value: token}}
+}
+
+// StaticIAMToken returns TokenSource always providing the IAM token with Bearer scheme
+func StaticIAMToken(token string) TokenSource {
+	return &staticTokenSource{token: Token{Scheme: iamAuthScheme, Value: token}}
+}
+
+// ServiceAccountKey describes authorized key of Yandex Cloud service account
+// (JSON file created by `yc iam key create`)
+type ServiceAccountKey struct {
+	// Key identifier.
+	ID string `json:"id"`
+	// Service account identifier.
+	ServiceAccountID string `json:"service_account_id"`
+	// Private key in PEM format.
+	PrivateKey string `json:"private_key"`
+}
+
+// LoadServiceAccountKey reads authorized key from the JSON file
+func LoadServiceAccountKey(path string) (*ServiceAccountKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var key ServiceAccountKey
+	if err := json.Unmarshal(data, &key); err != nil {
+		return nil, fmt.Errorf("invalid service account key: %w", err)
+	}
+	return &key, nil
+}
+
+// ServiceAccountTokenSource exchanges JWT signed by service account key for IAM token
+// and refreshes it before expiry. It is safe for concurrent use.
+type ServiceAccountTokenSource struct {
+	// IAM token exchange endpoint. Defaults to Yandex Cloud IAM API.
+	Endpoint string
+	// HTTP client used for exchange. Defaults to http.DefaultClient.
+	HTTPClient *http.Client
+	// How long before expiry the token is refreshed. Defaults to 5 minutes.
+	RefreshBefore time.Duration
+
+	key        *ServiceAccountKey
+	privateKey *rsa.PrivateKey
+
+	mu    sync.Mutex
+	token *Token
+}
+
+// NewServiceAccountTokenSource instantiates ServiceAccountTokenSource.
+// Exported fields can be adjusted before the first use.
+func NewServiceAccountTokenSource(key *ServiceAccountKey) (*ServiceAccountTokenSource, error) {
+	if key == nil || key.ID == "" || key.ServiceAccountID == "" {
+		return nil, errors.New("service account key ID and service account ID are required")
+	}
+	privateKey, err := parsePrivateKey(key.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	return &ServiceAccountTokenSource{
+		Endpoint:      defaultIAMTokenURL,
+		RefreshBefore: defaultRefreshBefore,
+		key:           key,
+		privateKey:    privateKey,
+	}, nil
+}
+
+func parsePrivateKey(data string) (*rsa.PrivateKey, error) {
+	// pem.Decode skips the text Yandex Cloud puts before the PEM block
+	block, _ := pem.Decode([]byte(data))
+	if block == nil {
+		return nil, errors.New("service account private key is not in PEM format")
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		privateKey, pkcs1Err := x509.ParsePKCS1PrivateKey(block.Bytes)
+		if pkcs1Err != nil {
+			return nil, fmt.Errorf("invalid service account private key: %w", err)
+		}
+		return privateKey, nil
+	}
+	privateKey, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("service account private key is not RSA")
+	}
+	return privateKey, nil
+}
+
+// Token returns cached IAM token or exchanges a new one if it expires soon
+func (s *ServiceAccountTokenSource) Token(ctx context.Context) (*Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token != nil && time.Until(s.token.Expiry) > s.RefreshBefore {
+		token := *s.token
+		return &token, nil
+	}
+	token, err := s.exchange(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s.token = token
+	result := *token
+	return &result, nil
+}
+
+// exchange requests new IAM token using signed JWT
+func (s *ServiceAccountTokenSource) exchange(ctx context.Context) (*Token, error) {
+	jwt, err := s.signedJWT(time.Now())
+	if err != nil {
+		return nil, err
+	}
+	httpClient := s.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	var respBody struct {
+		IAMToken  string    `json:"iamToken"`
+		ExpiresAt time.Time `json:"expiresAt"`
+	}
+	res, err := resty.NewWithClient(httpClient).R().
+		SetContext(ctx).
+		SetContentType(defaultContentType).
+		SetBody(map[string]string{"jwt": jwt}).
+		SetResult(&respBody).
+		Post(s.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+	if res.IsError() {
+		return nil, newAPIError(res)
+	}
+	if respBody.IAMToken == "" {
+		return nil, errors.New("IAM token exchange returned empty token")
+	}
+	return &Token{
+		Scheme: iamAuthScheme,
+		Value:  respBody.IAMToken,
+		Expiry: respBody.ExpiresAt,
+	}, nil
+}
+
+// signedJWT builds PS256 JWT for IAM token exchange
+func (s *ServiceAccountTokenSource) signedJWT(now time.Time) (string, error) {
+	header, err := json.Marshal(map[string]string{
+		"typ": "JWT",
+		"alg": "PS256",
+		"kid": s.key.ID,
+	})
+	if err != nil {
+		return "", err
+	}
+	claims, err := json.Marshal(map[string]any{
+		"iss": s.key.ServiceAccountID,
+		"aud": s.Endpoint,
+		"iat": now.Unix(),
+		"exp": now.Add(serviceAccountJWTLife).Unix(),
+	})
+	if err != nil {
+		return "", err
+	}
+	encoding := base64.RawURLEncoding
+	unsigned := encoding.EncodeToString(header) + "." + encoding.EncodeToString(claims)
+	digest := sha256.Sum256([]byte(unsigned))
+	signature, err := rsa.SignPSS(rand.Reader, s.privateKey, crypto.SHA256, digest[:], &rsa.PSSOptions{
+		SaltLength: rsa.PSSSaltLengthEqualsHash,
+	})
+	if err != nil {
+		return "", err
+	}
+	return unsigned + "." + encoding.EncodeToString(signature), nil
+}
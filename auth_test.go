@@ -0,0 +1,287 @@
+package client_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	client "github.com/IndianMax03/yandex-tracker-go-client"
+)
+
+// iamServer is a fake IAM token exchange endpoint verifying JWTs signed by the key
+type iamServer struct {
+	*httptest.Server
+	t         *testing.T
+	publicKey *rsa.PublicKey
+	tokenLife time.Duration
+	exchanges atomic.Int32
+
+	mu     sync.Mutex
+	header map[string]any
+	claims map[string]any
+}
+
+func newIAMServer(t *testing.T, publicKey *rsa.PublicKey, tokenLife time.Duration) *iamServer {
+	s := &iamServer{t: t, publicKey: publicKey, tokenLife: tokenLife}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.exchange))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *iamServer) exchange(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		JWT string `json:"jwt"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	parts := strings.Split(req.JWT, ".")
+	if len(parts) != 3 {
+		http.Error(w, "malformed JWT", http.StatusBadRequest)
+		return
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPSS(s.publicKey, crypto.SHA256, digest[:], signature, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash}); err != nil {
+		http.Error(w, "invalid signature", http.StatusUnauthorized)
+		return
+	}
+	var header, claims map[string]any
+	for _, part := range []struct {
+		encoded string
+		dest    *map[string]any
+	}{{parts[0], &header}, {parts[1], &claims}} {
+		decoded, err := base64.RawURLEncoding.DecodeString(part.encoded)
+		if err == nil {
+			err = json.Unmarshal(decoded, part.dest)
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	s.mu.Lock()
+	s.header, s.claims = header, claims
+	s.mu.Unlock()
+
+	n := s.exchanges.Add(1)
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"iamToken":  "t1.iam-token-" + strconv.Itoa(int(n)),
+		"expiresAt": time.Now().Add(s.tokenLife).UTC().Format(time.RFC3339Nano),
+	})
+}
+
+// lastJWT returns header and claims of the last exchanged JWT
+func (s *iamServer) lastJWT() (map[string]any, map[string]any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.header, s.claims
+}
+
+// newServiceAccountKey generates RSA key and returns it with the authorized key in the format
+func newServiceAccountKey(t *testing.T, pkcs8 bool) (*rsa.PrivateKey, *client.ServiceAccountKey) {
+	t.Helper()
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	block := &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)}
+	if pkcs8 {
+		der, err := x509.MarshalPKCS8PrivateKey(privateKey)
+		if err != nil {
+			t.Fatalf("failed to marshal key: %v", err)
+		}
+		block = &pem.Block{Type: "PRIVATE KEY", Bytes: der}
+	}
+	return privateKey, &client.ServiceAccountKey{
+		ID:               "ajekey",
+		ServiceAccountID: "ajeaccount",
+		PrivateKey:       "PLEASE DO NOT REMOVE THIS LINE! Yandex.Cloud SA Key ID <ajekey>\n" + string(pem.EncodeToMemory(block)),
+	}
+}
+
+// newTokenSource instantiates ServiceAccountTokenSource exchanging tokens at the IAM server
+func newTokenSource(t *testing.T, pkcs8 bool, tokenLife time.Duration) (*client.ServiceAccountTokenSource, *iamServer) {
+	t.Helper()
+	privateKey, key := newServiceAccountKey(t, pkcs8)
+	iam := newIAMServer(t, &privateKey.PublicKey, tokenLife)
+	source, err := client.NewServiceAccountTokenSource(key)
+	if err != nil {
+		t.Fatalf("NewServiceAccountTokenSource failed: %v", err)
+	}
+	source.Endpoint = iam.URL
+	source.HTTPClient = iam.Client()
+	return source, iam
+}
+
+func TestServiceAccountTokenSourceJWT(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		pkcs8 bool
+	}{{"PKCS#1", false}, {"PKCS#8", true}} {
+		t.Run(tt.name, func(t *testing.T) {
+			source, iam := newTokenSource(t, tt.pkcs8, time.Hour)
+
+			token, err := source.Token(context.Background())
+			if err != nil {
+				t.Fatalf("Token failed: %v", err)
+			}
+			if token.Scheme != "Bearer" || token.Value != "t1.iam-token-1" {
+				t.Errorf("got token %s %s", token.Scheme, token.Value)
+			}
+			if until := time.Until(token.Expiry); until < 59*time.Minute || until > time.Hour {
+				t.Errorf("got token expiring in %v, want an hour", until)
+			}
+
+			header, claims := iam.lastJWT()
+			if header["alg"] != "PS256" || header["typ"] != "JWT" || header["kid"] != "ajekey" {
+				t.Errorf("got JWT header %v", header)
+			}
+			if claims["iss"] != "ajeaccount" || claims["aud"] != iam.URL {
+				t.Errorf("got JWT claims %v", claims)
+			}
+			iat, _ := claims["iat"].(float64)
+			exp, _ := claims["exp"].(float64)
+			if now := float64(time.Now().Unix()); iat < now-60 || iat > now || exp-iat != time.Hour.Seconds() {
+				t.Errorf("got iat %v and exp %v, want exp an hour after now", iat, exp)
+			}
+		})
+	}
+}
+
+func TestServiceAccountTokenSourceReusesToken(t *testing.T) {
+	source, iam := newTokenSource(t, true, time.Hour)
+	for range 3 {
+		token, err := source.Token(context.Background())
+		if err != nil {
+			t.Fatalf("Token failed: %v", err)
+		}
+		if token.Value != "t1.iam-token-1" {
+			t.Errorf("got token %q, want the cached one", token.Value)
+		}
+	}
+	if got := iam.exchanges.Load(); got != 1 {
+		t.Errorf("got %d exchanges, want 1", got)
+	}
+}
+
+func TestServiceAccountTokenSourceRefreshesBeforeExpiry(t *testing.T) {
+	// tokens expire in 3 minutes, inside the default 5 minutes refresh window
+	source, iam := newTokenSource(t, true, 3*time.Minute)
+	for i, want := range []string{"t1.iam-token-1", "t1.iam-token-2"} {
+		token, err := source.Token(context.Background())
+		if err != nil {
+			t.Fatalf("Token failed: %v", err)
+		}
+		if token.Value != want {
+			t.Errorf("got token %q on call %d, want %q", token.Value, i+1, want)
+		}
+	}
+
+	source.RefreshBefore = time.Minute
+	if _, err := source.Token(context.Background()); err != nil {
+		t.Fatalf("Token failed: %v", err)
+	}
+	if got := iam.exchanges.Load(); got != 2 {
+		t.Errorf("got %d exchanges, want the token outside the refresh window reused", got)
+	}
+}
+
+func TestServiceAccountTokenSourceConcurrentExchange(t *testing.T) {
+	source, iam := newTokenSource(t, true, time.Hour)
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := source.Token(context.Background()); err != nil {
+				t.Errorf("Token failed: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+	if got := iam.exchanges.Load(); got != 1 {
+		t.Errorf("got %d exchanges, want 1", got)
+	}
+}
+
+func TestServiceAccountTokenSourceErrors(t *testing.T) {
+	_, key := newServiceAccountKey(t, true)
+	if _, err := client.NewServiceAccountTokenSource(&client.ServiceAccountKey{ID: key.ID, ServiceAccountID: key.ServiceAccountID, PrivateKey: "not a key"}); err == nil {
+		t.Error("got no error for key not in PEM format")
+	}
+	if _, err := client.NewServiceAccountTokenSource(&client.ServiceAccountKey{ID: key.ID, PrivateKey: key.PrivateKey}); err == nil {
+		t.Error("got no error for key without service account ID")
+	}
+
+	// the endpoint verifies signatures with another key
+	source, err := client.NewServiceAccountTokenSource(key)
+	if err != nil {
+		t.Fatalf("NewServiceAccountTokenSource failed: %v", err)
+	}
+	otherKey, _ := newServiceAccountKey(t, true)
+	iam := newIAMServer(t, &otherKey.PublicKey, time.Hour)
+	source.Endpoint, source.HTTPClient = iam.URL, iam.Client()
+	if _, err := source.Token(context.Background()); err == nil {
+		t.Error("got no error for rejected exchange")
+	}
+}
+
+// authRecorder is a transport remembering Authorization headers of the requests
+type authRecorder struct {
+	next http.RoundTripper
+
+	mu      sync.Mutex
+	headers []string
+}
+
+func (a *authRecorder) RoundTrip(r *http.Request) (*http.Response, error) {
+	a.mu.Lock()
+	a.headers = append(a.headers, r.Header.Get("Authorization"))
+	a.mu.Unlock()
+	return a.next.RoundTrip(r)
+}
+
+func TestServiceAccountTokenSourceAuthorizesRequests(t *testing.T) {
+	source, iam := newTokenSource(t, true, time.Hour)
+	srv, _ := newTestClient(t)
+	recorder := &authRecorder{next: srv.HTTPClient().Transport}
+	c, err := srv.Client(client.WithTokenSource(source), client.WithHTTPClient(&http.Client{Transport: recorder}))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	for range 2 {
+		if _, err := c.GetMyself(); err != nil {
+			t.Fatalf("GetMyself failed: %v", err)
+		}
+	}
+	for _, header := range recorder.headers {
+		if header != "Bearer t1.iam-token-1" {
+			t.Errorf("got Authorization %q, want Bearer with the IAM token", header)
+		}
+	}
+	if len(recorder.headers) != 2 || iam.exchanges.Load() != 1 {
+		t.Errorf("got %d requests and %d exchanges", len(recorder.headers), iam.exchanges.Load())
+	}
+}
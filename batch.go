@@ -0,0 +1,149 @@
+// Package client provides methods, values and urls for interacting with Yandex Tracker
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	model "github.com/IndianMax03/yandex-tracker-go-client/model"
+)
+
+const (
+	defaultBatchChunkSize   = 100
+	defaultBatchConcurrency = 4
+)
+
+// GetIssuesOptions configures GetIssues. Zero values are replaced with defaults.
+type GetIssuesOptions struct {
+	// Number of keys requested by a single search request. Default is 100.
+	ChunkSize int
+	// Maximum number of search requests sent in parallel. Default is 4.
+	Concurrency int
+}
+
+// IssueNotFoundError is reported by GetIssues for keys that matched no issue.
+// It matches ErrNotFound with errors.Is.
+type IssueNotFoundError struct {
+	Key string
+}
+
+// Error implements error interface
+func (e *IssueNotFoundError) Error() string {
+	return fmt.Sprintf("issue %s not found", e.Key)
+}
+
+// Is reports whether target is ErrNotFound
+func (e *IssueNotFoundError) Is(target error) bool {
+	return target == ErrNotFound
+}
+
+// IssueResult is the outcome of fetching one of the keys passed to GetIssues
+type IssueResult struct {
+	// Requested key.
+	Key string
+	// Found issue. Nil if Err is set.
+	Issue *model.IssueResponse
+	// *IssueNotFoundError if the key matched no issue, otherwise error of the search request that contained the key.
+	Err error
+}
+
+// GetIssues sends requests to get issues by keys, see GetIssuesWithContext
+func (c *Client) GetIssues(keys []string, opts *GetIssuesOptions) ([]IssueResult, error) {
+	return c.GetIssuesWithContext(context.Background(), keys, opts)
+}
+
+// GetIssuesWithContext gets issues by keys using keys search split into chunks requested in parallel.
+// Results are returned in the order of keys, one per key. Keys that matched no issue get *IssueNotFoundError
+// and do not fail the call. The returned error is the first failure of a search request; results of
+// the other chunks are still returned.
+func (c *Client) GetIssuesWithContext(ctx context.Context, keys []string, opts *GetIssuesOptions) (results []IssueResult, err error) {
+	ctx, span := c.startPaginationSpan(ctx, "GetIssues")
+	defer func() { endPaginationSpan(span, countFound(results), err) }()
+
+	chunkSize, concurrency := defaultBatchChunkSize, defaultBatchConcurrency
+	if opts != nil && opts.ChunkSize > 0 {
+		chunkSize = opts.ChunkSize
+	}
+	if opts != nil && opts.Concurrency > 0 {
+		concurrency = opts.Concurrency
+	}
+
+	results = make([]IssueResult, len(keys))
+	for i, key := range keys {
+		results[i].Key = key
+	}
+	errs := make([]error, (len(keys)+chunkSize-1)/chunkSize)
+	semaphore := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for chunk := range errs {
+		start := chunk * chunkSize
+		end := min(start+chunkSize, len(keys))
+		select {
+		case semaphore <- struct{}{}:
+		case <-ctx.Done():
+			errs[chunk] = ctx.Err()
+			setResultErrors(results[start:end], ctx.Err())
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+			errs[chunk] = c.getIssuesChunk(ctx, results[start:end])
+		}()
+	}
+	wg.Wait()
+
+	for _, chunkErr := range errs {
+		if chunkErr != nil {
+			return results, chunkErr
+		}
+	}
+	return results, nil
+}
+
+// getIssuesChunk fills results of the chunk with a single keys search
+func (c *Client) getIssuesChunk(ctx context.Context, results []IssueResult) error {
+	keys := make([]string, 0, len(results))
+	for _, result := range results {
+		keys = append(keys, result.Key)
+	}
+	issues, _, err := c.SearchIssuesPageWithContext(ctx, &model.IssueSearchRequest{Keys: keys}, &model.PageRequest{
+		PerPage: len(keys),
+		Page:    1,
+	})
+	if err != nil {
+		setResultErrors(results, err)
+		return err
+	}
+	byKey := make(map[string]*model.IssueResponse, len(issues))
+	for i := range issues {
+		byKey[strings.ToUpper(issues[i].Key)] = &issues[i]
+	}
+	for i := range results {
+		if issue, ok := byKey[strings.ToUpper(results[i].Key)]; ok {
+			results[i].Issue = issue
+		} else {
+			results[i].Err = &IssueNotFoundError{Key: results[i].Key}
+		}
+	}
+	return nil
+}
+
+func setResultErrors(results []IssueResult, err error) {
+	for i := range results {
+		results[i].Err = err
+	}
+}
+
+func countFound(results []IssueResult) int {
+	found := 0
+	for _, result := range results {
+		if result.Issue != nil {
+			found++
+		}
+	}
+	return found
+}
@@ -0,0 +1,107 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"testing"
+
+	client "github.com/IndianMax03/yandex-tracker-go-client"
+	"github.com/IndianMax03/yandex-tracker-go-client/model"
+	"github.com/IndianMax03/yandex-tracker-go-client/trackertest"
+	"resty.dev/v3"
+)
+
+// batchKeys returns keys of the stored issues in reversed order with a missing key in the last chunk of 3
+func batchKeys(t *testing.T, srv *trackertest.Server) []string {
+	t.Helper()
+	keys := addIssues(t, srv, "TEST", 7)
+	slices.Reverse(keys)
+	return slices.Insert(keys, 7, "TEST-404")
+}
+
+// checkIssueResult checks the result of a found key
+func checkIssueResult(t *testing.T, result client.IssueResult, key string) {
+	t.Helper()
+	if result.Key != key {
+		t.Errorf("got result of %s, want %s", result.Key, key)
+	}
+	if result.Err != nil || result.Issue == nil || result.Issue.Key != key {
+		t.Errorf("got result %+v, want issue %s", result, key)
+	}
+}
+
+// checkNotFoundResult checks the result of the missing key
+func checkNotFoundResult(t *testing.T, result client.IssueResult) {
+	t.Helper()
+	var notFound *client.IssueNotFoundError
+	if !errors.As(result.Err, &notFound) || notFound.Key != result.Key || !errors.Is(result.Err, client.ErrNotFound) {
+		t.Errorf("got error %v for %s, want IssueNotFoundError", result.Err, result.Key)
+	}
+	if result.Issue != nil {
+		t.Errorf("got issue %s for the missing key %s", result.Issue.Key, result.Key)
+	}
+}
+
+func TestGetIssues(t *testing.T) {
+	srv, c := newTestClient(t)
+	keys := batchKeys(t, srv)
+
+	results, err := c.GetIssues(keys, &client.GetIssuesOptions{ChunkSize: 3, Concurrency: 3})
+	if err != nil {
+		t.Fatalf("GetIssues failed: %v", err)
+	}
+	if len(results) != len(keys) {
+		t.Fatalf("got %d results, want %d", len(results), len(keys))
+	}
+	for i, key := range keys {
+		if key == "TEST-404" {
+			checkNotFoundResult(t, results[i])
+		} else {
+			checkIssueResult(t, results[i], key)
+		}
+	}
+	if got := countRequests(srv, http.MethodPost, "/issues/_search"); got != 3 {
+		t.Errorf("got %d search requests, want one per chunk: 3", got)
+	}
+}
+
+func TestGetIssuesChunkError(t *testing.T) {
+	srv := trackertest.NewServer()
+	t.Cleanup(srv.Close)
+	var keys []string
+	failSecondChunk := func(next client.Handler) client.Handler {
+		return func(ctx context.Context, req *client.Request) (*resty.Response, error) {
+			if search, ok := req.Body.(*model.IssueSearchRequest); ok && len(search.Keys) != 0 && search.Keys[0] == keys[3] {
+				srv.InjectFault(trackertest.Fault{PathPrefix: "/issues/_search", StatusCode: http.StatusServiceUnavailable, Times: 1})
+			}
+			return next(ctx, req)
+		}
+	}
+	c, err := srv.Client(client.WithMiddleware(failSecondChunk))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	keys = batchKeys(t, srv)
+
+	results, err := c.GetIssues(keys, &client.GetIssuesOptions{ChunkSize: 3, Concurrency: 1})
+	if !errors.Is(err, client.ErrServerError) {
+		t.Fatalf("got error %v, want ErrServerError", err)
+	}
+	if len(results) != len(keys) {
+		t.Fatalf("got %d results, want %d", len(results), len(keys))
+	}
+	for i, key := range keys {
+		switch {
+		case i >= 3 && i < 6:
+			if results[i].Key != key || results[i].Issue != nil || !errors.Is(results[i].Err, client.ErrServerError) {
+				t.Errorf("got result %+v, want the chunk error for %s", results[i], key)
+			}
+		case key == "TEST-404":
+			checkNotFoundResult(t, results[i])
+		default:
+			checkIssueResult(t, results[i], key)
+		}
+	}
+}
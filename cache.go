@@ -0,0 +1,226 @@
+// Package client provides methods, values and urls for interacting with Yandex Tracker
+package client
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"resty.dev/v3"
+)
+
+// cachedHeaders are response headers kept together with the cached body
+var cachedHeaders = []string{"Content-Type", "ETag", "X-Total-Pages", "X-Total-Count"}
+
+// keyHeaders are request headers included in the cache key
+var keyHeaders = []string{"X-Org-ID", "X-Cloud-Org-ID", "Accept-Language"}
+
+// CachedResponse is a response of reference data stored in Cache
+type CachedResponse struct {
+	// Response body in JSON.
+	Body []byte
+	// Response headers needed to serve the response from cache, e.g. X-Total-Pages.
+	Header http.Header
+	// Time after which the response must be revalidated.
+	Expires time.Time
+}
+
+// Cache stores responses of reference data. Keys start with the resource path, e.g. /components/,
+// and include the organization, language and a hash of the token of the request, so one Cache can be shared
+// by several clients without leaking responses between users.
+// Implementations must be safe for concurrent use.
+type Cache interface {
+	// Get returns the response stored by key. Expired responses are returned as well for revalidation.
+	Get(key string) (*CachedResponse, bool)
+	// Set stores the response by key.
+	Set(key string, res *CachedResponse)
+	// DeletePrefix removes responses whose keys start with prefix. Empty prefix removes everything.
+	DeletePrefix(prefix string)
+}
+
+// CacheConfig describes which reference data is cached and for how long. Zero TTL disables caching of the resource.
+type CacheConfig struct {
+	// Storage of responses. In-memory cache is used if nil.
+	Cache Cache
+	// TTL of priorities.
+	PrioritiesTTL time.Duration
+	// TTL of users including the current one. Responses are cached per token, see Cache.
+	UsersTTL time.Duration
+	// TTL of components. They are also invalidated after CreateComponent and UpdateComponent.
+	ComponentsTTL time.Duration
+}
+
+// ttl returns TTL of the resource or 0 if it is not cached
+func (c *CacheConfig) ttl(resourceURL string) time.Duration {
+	switch {
+	case strings.HasPrefix(resourceURL, prioritiesBaseURL):
+		return c.PrioritiesTTL
+	case strings.HasPrefix(resourceURL, userBaseURL), resourceURL == myselfURL:
+		return c.UsersTTL
+	case strings.HasPrefix(resourceURL, componentBaseURL):
+		return c.ComponentsTTL
+	}
+	return 0
+}
+
+// cachedResources are invalidated by mutating requests to them
+var cachedResources = []string{prioritiesBaseURL, userBaseURL, componentBaseURL}
+
+// memoryCache is the default in-memory Cache
+type memoryCache struct {
+	mu      sync.RWMutex
+	entries map[string]*CachedResponse
+}
+
+// NewMemoryCache instantiates in-memory Cache
+func NewMemoryCache() Cache {
+	return &memoryCache{entries: map[string]*CachedResponse{}}
+}
+
+func (m *memoryCache) Get(key string) (*CachedResponse, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	res, ok := m.entries[key]
+	return res, ok
+}
+
+func (m *memoryCache) Set(key string, res *CachedResponse) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entries[key] = res
+}
+
+func (m *memoryCache) DeletePrefix(prefix string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	maps.DeleteFunc(m.entries, func(key string, _ *CachedResponse) bool {
+		return strings.HasPrefix(key, prefix)
+	})
+}
+
+// SetCache enables caching of priorities, users and components. Nil config disables caching.
+// Expired responses are revalidated with If-None-Match when Tracker has sent ETag.
+func (c *Client) SetCache(config *CacheConfig) {
+	c.cache = newCacheConfig(config)
+}
+
+func newCacheConfig(config *CacheConfig) *CacheConfig {
+	if config == nil {
+		return nil
+	}
+	result := *config
+	if result.Cache == nil {
+		result.Cache = NewMemoryCache()
+	}
+	return &result
+}
+
+// InvalidateCache removes all of the cached responses
+func (c *Client) InvalidateCache() {
+	if c.cache != nil {
+		c.cache.Cache.DeletePrefix("")
+	}
+}
+
+// cacheKey returns the resource path with substituted path params followed by sorted query
+// and the headers the response depends on, so clients of different organizations and users can share the cache.
+// The token is included as a hash, so it is not exposed to the Cache implementation.
+func (c *Client) cacheKey(ctx context.Context, req *Request) (string, error) {
+	path := resolvePath(req)
+	query := url.Values{}
+	for name, value := range req.QueryParams {
+		query.Set(name, value)
+	}
+	for name, values := range req.MultiplyQueryParams {
+		query[name] = append(query[name], values...)
+	}
+	header := url.Values{}
+	for _, name := range keyHeaders {
+		value := req.Header.Get(name)
+		if value == "" {
+			value = c.restyClient.Header().Get(name)
+		}
+		if value != "" {
+			header.Set(name, value)
+		}
+	}
+	if c.tokenSource != nil {
+		token, err := c.tokenSource.Token(ctx)
+		if err != nil {
+			return "", fmt.Errorf("failed to get token: %w", err)
+		}
+		sum := sha256.Sum256([]byte(token.Scheme + " " + token.Value))
+		header.Set("Authorization", hex.EncodeToString(sum[:]))
+	}
+	return path + "?" + query.Encode() + "#" + header.Encode(), nil
+}
+
+// cached wraps handler serving GET requests of reference data from cache
+// and invalidating it after mutating requests to the same resource
+func (c *Client) cached(next Handler) Handler {
+	return func(ctx context.Context, req *Request) (*resty.Response, error) {
+		config := c.cache
+		if config == nil {
+			return next(ctx, req)
+		}
+		if req.Method != resty.MethodGet {
+			res, err := next(ctx, req)
+			if err == nil && !res.IsError() {
+				for _, resource := range cachedResources {
+					if strings.HasPrefix(req.ResourceURL, resource) {
+						config.Cache.DeletePrefix(resource)
+					}
+				}
+			}
+			return res, err
+		}
+		ttl := config.ttl(req.ResourceURL)
+		if ttl <= 0 {
+			return next(ctx, req)
+		}
+
+		key, err := c.cacheKey(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		entry, found := config.Cache.Get(key)
+		if found && time.Now().Before(entry.Expires) {
+			return c.NewResponse(req, http.StatusOK, entry.Header.Clone(), entry.Body)
+		}
+		if found && entry.Header.Get("ETag") != "" {
+			req.Header.Set("If-None-Match", entry.Header.Get("ETag"))
+		}
+		res, err := next(ctx, req)
+		if err != nil {
+			return res, err
+		}
+		switch {
+		case found && res.StatusCode() == http.StatusNotModified:
+			drainBody(res)
+			entry = &CachedResponse{Body: entry.Body, Header: entry.Header, Expires: time.Now().Add(ttl)}
+			config.Cache.Set(key, entry)
+			return c.NewResponse(req, http.StatusOK, entry.Header.Clone(), entry.Body)
+		case res.StatusCode() == http.StatusOK && req.Result != nil:
+			body, err := json.Marshal(req.Result)
+			if err != nil {
+				return res, nil
+			}
+			header := http.Header{}
+			for _, name := range cachedHeaders {
+				if value := res.Header().Get(name); value != "" {
+					header.Set(name, value)
+				}
+			}
+			config.Cache.Set(key, &CachedResponse{Body: body, Header: header, Expires: time.Now().Add(ttl)})
+		}
+		return res, nil
+	}
+}
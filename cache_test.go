@@ -0,0 +1,111 @@
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	client "github.com/IndianMax03/yandex-tracker-go-client"
+	"resty.dev/v3"
+)
+
+func TestCacheHitReadableByMiddleware(t *testing.T) {
+	var bodies []string
+	readBody := func(next client.Handler) client.Handler {
+		return func(ctx context.Context, req *client.Request) (*resty.Response, error) {
+			res, err := next(ctx, req)
+			if err == nil {
+				bodies = append(bodies, res.String())
+				_ = res.Duration()
+			}
+			return res, err
+		}
+	}
+	srv, c := newTestClient(t, client.WithCache(client.CacheConfig{PrioritiesTTL: time.Minute}), client.WithMiddleware(readBody))
+
+	for range 2 {
+		priority, err := c.GetPriority(1, false)
+		if err != nil {
+			t.Fatalf("GetPriority failed: %v", err)
+		}
+		if priority.Key != "trivial" {
+			t.Errorf("got priority %q, want trivial", priority.Key)
+		}
+	}
+	if got := countRequests(srv, http.MethodGet, "/priorities/1"); got != 1 {
+		t.Errorf("got %d requests, want 1", got)
+	}
+	if len(bodies) != 2 || !strings.Contains(bodies[1], `"key":"trivial"`) {
+		t.Errorf("got bodies %q, want the cached one to contain the priority", bodies)
+	}
+}
+
+func TestCacheSharedBetweenOrganizations(t *testing.T) {
+	srv, _ := newTestClient(t)
+	config := client.CacheConfig{Cache: client.NewMemoryCache(), UsersTTL: time.Minute}
+	newClient := func(opts ...client.Option) *client.Client {
+		c, err := srv.Client(append(opts, client.WithCache(config))...)
+		if err != nil {
+			t.Fatalf("failed to create client: %v", err)
+		}
+		return c
+	}
+	cloudClient, err := client.NewWithOptions(
+		client.WithOAuthToken("trackertest-token"),
+		client.WithCloudOrgID("1"),
+		client.WithBaseURL(srv.URL),
+		client.WithHTTPClient(srv.HTTPClient()),
+		client.WithCache(config),
+	)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	clients := []*client.Client{
+		cloudClient,
+		newClient(client.WithOrgID("1")),
+		newClient(client.WithOrgID("2")),
+		newClient(client.WithOrgID("1"), client.WithLanguage("en")),
+	}
+
+	for range 2 {
+		for _, c := range clients {
+			if _, err := c.GetMyself(); err != nil {
+				t.Fatalf("GetMyself failed: %v", err)
+			}
+		}
+	}
+	if got := countRequests(srv, http.MethodGet, "/myself"); got != len(clients) {
+		t.Errorf("got %d requests, want one per organization and language: %d", got, len(clients))
+	}
+}
+
+func TestCacheSeparatesTokens(t *testing.T) {
+	srv, _ := newTestClient(t)
+	config := client.CacheConfig{Cache: client.NewMemoryCache(), UsersTTL: time.Minute}
+	var clients []*client.Client
+	for _, token := range []string{"first-token", "second-token", "first-token"} {
+		c, err := srv.Client(client.WithOAuthToken(token), client.WithCache(config))
+		if err != nil {
+			t.Fatalf("failed to create client: %v", err)
+		}
+		clients = append(clients, c)
+	}
+
+	for range 2 {
+		for _, c := range clients {
+			if _, err := c.GetMyself(); err != nil {
+				t.Fatalf("GetMyself failed: %v", err)
+			}
+			if _, err := c.GetUser("", 1); err != nil {
+				t.Fatalf("GetUser failed: %v", err)
+			}
+		}
+	}
+	for _, path := range []string{"/myself", "/users/1"} {
+		if got := countRequests(srv, http.MethodGet, path); got != 2 {
+			t.Errorf("got %d requests of %s, want one per token: 2", got, path)
+		}
+	}
+}
@@ -0,0 +1,471 @@
+// Package cassette records HTTP interactions with Yandex Tracker to files and replays them without network.
+//
+//	rec, err := cassette.New("testdata/create_issue.json", cassette.ModeReplay)
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+//	defer func() {
+//		if err := rec.Stop(); err != nil {
+//			t.Error(err)
+//		}
+//	}()
+//	c, err := client.NewWithOptions(
+//		client.WithOAuthToken(os.Getenv("TRACKER_TOKEN")),
+//		client.WithOrgID(os.Getenv("TRACKER_ORG_ID")),
+//		client.WithHTTPClient(rec.HTTPClient()),
+//	)
+package cassette
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	client "github.com/IndianMax03/yandex-tracker-go-client"
+)
+
+// Version is the version of the cassette file format
+const Version = 1
+
+const (
+	redacted          = "[REDACTED]"
+	multipartBoundary = "cassette-boundary"
+)
+
+// ErrNoInteraction is returned in replay mode for requests that have no matching recorded interaction
+var ErrNoInteraction = errors.New("cassette: no recorded interaction matches the request")
+
+// Mode defines whether requests are sent to the server or answered from the file
+type Mode int
+
+const (
+	// ModeReplay answers requests with recorded responses and never touches network.
+	ModeReplay Mode = iota
+	// ModeRecord sends requests to the server and saves interactions to the file on Stop.
+	ModeRecord
+)
+
+// sensitiveHeaders are replaced with the redacted placeholder. Org IDs and the token are also scrubbed
+// from paths, queries and JSON bodies where they occur as a whole value.
+var sensitiveHeaders = []string{"Authorization", "X-Org-ID", "X-Cloud-Org-ID", "Cookie", "Set-Cookie"}
+
+// tokenFields are JSON fields and query parameters that always hold credentials
+var tokenFields = []string{"iamToken", "access_token", "refresh_token", "oauth_token", "token", "jwt", "private_key", "password"}
+
+// Cassette is the content of the cassette file
+type Cassette struct {
+	// Version of the file format.
+	Version int `json:"version"`
+	// Interactions in order they were recorded.
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is a recorded request/response pair
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest describes the recorded request
+type RecordedRequest struct {
+	Method string      `json:"method"`
+	Path   string      `json:"path"`
+	Query  string      `json:"query,omitempty"`
+	Header http.Header `json:"header,omitempty"`
+	Body   Body        `json:"body,omitzero"`
+}
+
+// RecordedResponse describes the recorded response
+type RecordedResponse struct {
+	StatusCode int         `json:"statusCode"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       Body        `json:"body,omitzero"`
+}
+
+// Body is stored as text when it is valid UTF-8 and as base64 otherwise
+type Body []byte
+
+// MarshalJSON implements json.Marshaler
+func (b Body) MarshalJSON() ([]byte, error) {
+	if utf8.Valid(b) {
+		return json.Marshal(map[string]string{"text": string(b)})
+	}
+	return json.Marshal(map[string]string{"base64": base64.StdEncoding.EncodeToString(b)})
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (b *Body) UnmarshalJSON(data []byte) error {
+	var v struct {
+		Text   string `json:"text"`
+		Base64 string `json:"base64"`
+	}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	if v.Base64 == "" {
+		*b = Body(v.Text)
+		return nil
+	}
+	decoded, err := base64.StdEncoding.DecodeString(v.Base64)
+	if err != nil {
+		return err
+	}
+	*b = decoded
+	return nil
+}
+
+// Option configures Recorder
+type Option func(*Recorder)
+
+// WithTransport sets transport used to send requests in record mode. http.DefaultTransport is used by default.
+func WithTransport(transport http.RoundTripper) Option {
+	return func(r *Recorder) {
+		r.transport = transport
+	}
+}
+
+// WithScrubber adds function applied to every interaction before it is saved, e.g. to remove personal data
+func WithScrubber(scrub func(*Interaction)) Option {
+	return func(r *Recorder) {
+		r.scrubbers = append(r.scrubbers, scrub)
+	}
+}
+
+// Recorder is http.RoundTripper that records or replays interactions. It is safe for concurrent use.
+type Recorder struct {
+	path      string
+	mode      Mode
+	transport http.RoundTripper
+	scrubbers []func(*Interaction)
+
+	mu        sync.Mutex
+	cassette  Cassette
+	used      []bool
+	secrets   []string
+	unmatched []string
+}
+
+// New instantiates Recorder of the cassette file. In replay mode the file must exist and have the supported version.
+func New(path string, mode Mode, opts ...Option) (*Recorder, error) {
+	r := &Recorder{
+		path:      path,
+		mode:      mode,
+		transport: http.DefaultTransport,
+		cassette:  Cassette{Version: Version},
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	switch mode {
+	case ModeRecord:
+	case ModeReplay:
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read cassette: %w", err)
+		}
+		if err := json.Unmarshal(data, &r.cassette); err != nil {
+			return nil, fmt.Errorf("failed to decode cassette %s: %w", path, err)
+		}
+		if r.cassette.Version != Version {
+			return nil, fmt.Errorf("cassette %s has version %d, supported version is %d", path, r.cassette.Version, Version)
+		}
+		r.used = make([]bool, len(r.cassette.Interactions))
+	default:
+		return nil, fmt.Errorf("unknown cassette mode: %d", mode)
+	}
+	return r, nil
+}
+
+// HTTPClient returns *http.Client to be passed to client.WithHTTPClient
+func (r *Recorder) HTTPClient() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// RoundTrip implements http.RoundTripper
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readBody(req)
+	if err != nil {
+		return nil, err
+	}
+	if r.mode == ModeRecord {
+		return r.record(req, body)
+	}
+	return r.replay(req, body)
+}
+
+// Stop saves recorded interactions in record mode.
+// In replay mode it reports requests that had no matching interaction.
+func (r *Recorder) Stop() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.mode == ModeReplay {
+		if len(r.unmatched) != 0 {
+			return fmt.Errorf("%w:\n%s", ErrNoInteraction, strings.Join(r.unmatched, "\n"))
+		}
+		return nil
+	}
+	for i := range r.cassette.Interactions {
+		r.scrub(&r.cassette.Interactions[i])
+	}
+	data, err := json.MarshalIndent(r.cassette, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode cassette: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return fmt.Errorf("failed to create cassette directory: %w", err)
+	}
+	if err := os.WriteFile(r.path, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("failed to write cassette: %w", err)
+	}
+	return nil
+}
+
+func (r *Recorder) record(req *http.Request, body []byte) (*http.Response, error) {
+	res, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	resBody, err := io.ReadAll(res.Body)
+	_ = res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(resBody))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, secret := range requestSecrets(req.Header) {
+		if !slices.Contains(r.secrets, secret) {
+			r.secrets = append(r.secrets, secret)
+		}
+	}
+	header := req.Header.Clone()
+	body, contentType := normalizeMultipart(header.Get("Content-Type"), body)
+	if contentType != "" {
+		header.Set("Content-Type", contentType)
+	}
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Request: RecordedRequest{
+			Method: req.Method,
+			Path:   req.URL.Path,
+			Query:  req.URL.Query().Encode(),
+			Header: header,
+			Body:   body,
+		},
+		Response: RecordedResponse{
+			StatusCode: res.StatusCode,
+			Header:     res.Header.Clone(),
+			Body:       resBody,
+		},
+	})
+	return res, nil
+}
+
+func (r *Recorder) replay(req *http.Request, body []byte) (*http.Response, error) {
+	// recorded interactions are scrubbed, so the request is compared after the same scrubbing
+	secrets := requestSecrets(req.Header)
+	path, query := scrubPath(req.URL.Path, secrets), scrubQuery(req.URL.Query().Encode(), secrets)
+	normalized := normalizeBody(req.Header.Get("Content-Type"), scrubBody(body, secrets))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, interaction := range r.cassette.Interactions {
+		recorded := interaction.Request
+		if r.used[i] || recorded.Method != req.Method || recorded.Path != path || recorded.Query != query {
+			continue
+		}
+		if !bytes.Equal(normalizeBody(recorded.Header.Get("Content-Type"), recorded.Body), normalized) {
+			continue
+		}
+		r.used[i] = true
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode:    interaction.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        interaction.Response.Header.Clone(),
+			Body:          io.NopCloser(bytes.NewReader(interaction.Response.Body)),
+			ContentLength: int64(len(interaction.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+	description := fmt.Sprintf("%s %s?%s %s", req.Method, path, query, normalized)
+	r.unmatched = append(r.unmatched, description)
+	return nil, fmt.Errorf("%w in %s: %s", ErrNoInteraction, r.path, description)
+}
+
+// scrub removes tokens and org IDs from the interaction and applies custom scrubbers
+func (r *Recorder) scrub(interaction *Interaction) {
+	for _, header := range []http.Header{interaction.Request.Header, interaction.Response.Header} {
+		for _, name := range sensitiveHeaders {
+			if header.Get(name) != "" {
+				header.Set(name, redacted)
+			}
+		}
+		for _, values := range header {
+			for i := range values {
+				values[i] = client.Redact(scrubValue(values[i], r.secrets))
+			}
+		}
+	}
+	interaction.Request.Path = scrubPath(interaction.Request.Path, r.secrets)
+	interaction.Request.Query = scrubQuery(interaction.Request.Query, r.secrets)
+	interaction.Request.Body = scrubBody(interaction.Request.Body, r.secrets)
+	interaction.Response.Body = scrubBody(interaction.Response.Body, r.secrets)
+	for _, scrub := range r.scrubbers {
+		scrub(interaction)
+	}
+}
+
+// requestSecrets returns org IDs and the token sent in the request headers
+func requestSecrets(header http.Header) []string {
+	var secrets []string
+	for _, name := range []string{"X-Org-ID", "X-Cloud-Org-ID"} {
+		if value := header.Get(name); value != "" {
+			secrets = append(secrets, value)
+		}
+	}
+	if _, token, ok := strings.Cut(header.Get("Authorization"), " "); ok && token != "" {
+		secrets = append(secrets, token)
+	}
+	return secrets
+}
+
+func scrubValue(value string, secrets []string) string {
+	if slices.Contains(secrets, value) {
+		return redacted
+	}
+	return value
+}
+
+func isTokenField(name string) bool {
+	return slices.ContainsFunc(tokenFields, func(field string) bool { return strings.EqualFold(field, name) })
+}
+
+// scrubPath replaces path segments equal to a secret
+func scrubPath(path string, secrets []string) string {
+	segments := strings.Split(path, "/")
+	for i := range segments {
+		segments[i] = scrubValue(segments[i], secrets)
+	}
+	return strings.Join(segments, "/")
+}
+
+// scrubQuery replaces values of token parameters and values equal to a secret
+func scrubQuery(query string, secrets []string) string {
+	values, err := url.ParseQuery(query)
+	if err != nil {
+		return query
+	}
+	for name, list := range values {
+		for i := range list {
+			if isTokenField(name) {
+				list[i] = redacted
+			} else {
+				list[i] = scrubValue(list[i], secrets)
+			}
+		}
+	}
+	return values.Encode()
+}
+
+// scrubBody replaces values of token fields and string values equal to a secret in JSON bodies.
+// Other bodies are kept as is.
+func scrubBody(body Body, secrets []string) Body {
+	if !json.Valid(body) {
+		return body
+	}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var v any
+	if err := decoder.Decode(&v); err != nil {
+		return body
+	}
+	v, changed := scrubJSON(v, secrets)
+	if !changed {
+		return body
+	}
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(v); err != nil {
+		return body
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n"))
+}
+
+func scrubJSON(v any, secrets []string) (any, bool) {
+	changed := false
+	switch v := v.(type) {
+	case string:
+		if slices.Contains(secrets, v) {
+			return redacted, true
+		}
+	case map[string]any:
+		for key, value := range v {
+			if _, ok := value.(string); ok && isTokenField(key) && value != redacted {
+				v[key], changed = redacted, true
+				continue
+			}
+			if scrubbed, ok := scrubJSON(value, secrets); ok {
+				v[key], changed = scrubbed, true
+			}
+		}
+	case []any:
+		for i, value := range v {
+			if scrubbed, ok := scrubJSON(value, secrets); ok {
+				v[i], changed = scrubbed, true
+			}
+		}
+	}
+	return v, changed
+}
+
+// readBody reads the request body and restores it for the transport
+func readBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	body, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read request body: %w", err)
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+// normalizeBody makes JSON bodies independent of formatting and multipart bodies independent of the boundary
+func normalizeBody(contentType string, body []byte) []byte {
+	var v any
+	if json.Unmarshal(body, &v) == nil {
+		if normalized, err := json.Marshal(v); err == nil {
+			return normalized
+		}
+	}
+	normalized, _ := normalizeMultipart(contentType, body)
+	return normalized
+}
+
+// normalizeMultipart replaces the random multipart boundary with the fixed one in the body and the content type
+func normalizeMultipart(contentType string, body []byte) ([]byte, string) {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	boundary := params["boundary"]
+	if err != nil || !strings.HasPrefix(mediaType, "multipart/") || boundary == "" || boundary == multipartBoundary {
+		return body, contentType
+	}
+	return bytes.ReplaceAll(body, []byte(boundary), []byte(multipartBoundary)), strings.Replace(contentType, boundary, multipartBoundary, 1)
+}
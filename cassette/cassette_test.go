@@ -0,0 +1,287 @@
+package cassette_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	client "github.com/IndianMax03/yandex-tracker-go-client"
+	"github.com/IndianMax03/yandex-tracker-go-client/cassette"
+	"github.com/IndianMax03/yandex-tracker-go-client/model"
+	"github.com/IndianMax03/yandex-tracker-go-client/trackertest"
+	"resty.dev/v3"
+)
+
+const (
+	testToken = "y0_cassette-test-token"
+	testOrgID = "123456"
+)
+
+// newRecorder instantiates Recorder and fails the test on error
+func newRecorder(t *testing.T, path string, mode cassette.Mode, opts ...cassette.Option) *cassette.Recorder {
+	t.Helper()
+	rec, err := cassette.New(path, mode, opts...)
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+	return rec
+}
+
+// send sends the request through the recorder with the test credentials
+func send(t *testing.T, rec *cassette.Recorder, method, url, orgID, contentType string, body []byte) (*http.Response, error) {
+	t.Helper()
+	req, err := http.NewRequest(method, url, bytes.NewReader(body))
+	if err != nil {
+		t.Fatalf("failed to build request: %v", err)
+	}
+	req.Header.Set("Authorization", "OAuth "+testToken)
+	req.Header.Set("X-Org-ID", orgID)
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	return rec.HTTPClient().Do(req)
+}
+
+// multipartBody builds a multipart body with the random boundary
+func multipartBody(t *testing.T, content string) ([]byte, string) {
+	t.Helper()
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+	part, err := w.CreateFormFile("filename", "notes.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _ = part.Write([]byte(content))
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes(), w.FormDataContentType()
+}
+
+func TestRecordReplayRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "round_trip.json")
+	srv := trackertest.NewServer()
+
+	rec := newRecorder(t, path, cassette.ModeRecord, cassette.WithTransport(srv.HTTPClient().Transport))
+	recorded, err := srv.Client(client.WithOAuthToken(testToken), client.WithOrgID(testOrgID), client.WithHTTPClient(rec.HTTPClient()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	created, err := recorded.CreateIssue(&model.IssueCreateRequest{Summary: "Recorded", Queue: model.Queue{Key: "TEST"}})
+	if err != nil {
+		t.Fatalf("CreateIssue failed: %v", err)
+	}
+	uploaded, err := recorded.UploadTemporaryAttachment(&resty.MultipartField{Name: "filename", FileName: "notes.txt", Reader: strings.NewReader("hello")})
+	if err != nil {
+		t.Fatalf("UploadTemporaryAttachment failed: %v", err)
+	}
+	if err := rec.Stop(); err != nil {
+		t.Fatalf("Stop failed: %v", err)
+	}
+	srv.Close()
+
+	rec = newRecorder(t, path, cassette.ModeReplay)
+	replayed, err := client.NewWithOptions(
+		client.WithOAuthToken("another-token"),
+		client.WithOrgID("654321"),
+		client.WithBaseURL(srv.URL),
+		client.WithHTTPClient(rec.HTTPClient()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	issue, err := replayed.CreateIssue(&model.IssueCreateRequest{Summary: "Recorded", Queue: model.Queue{Key: "TEST"}})
+	if err != nil {
+		t.Fatalf("replayed CreateIssue failed: %v", err)
+	}
+	if issue.Key != created.Key || issue.Summary != "Recorded" {
+		t.Errorf("got issue %s %q, want %s %q", issue.Key, issue.Summary, created.Key, "Recorded")
+	}
+	attachment, err := replayed.UploadTemporaryAttachment(&resty.MultipartField{Name: "filename", FileName: "notes.txt", Reader: strings.NewReader("hello")})
+	if err != nil {
+		t.Fatalf("replayed UploadTemporaryAttachment failed: %v", err)
+	}
+	if attachment.ID != uploaded.ID {
+		t.Errorf("got attachment %s, want %s", attachment.ID, uploaded.ID)
+	}
+	if err := rec.Stop(); err != nil {
+		t.Errorf("Stop failed after replay: %v", err)
+	}
+}
+
+func TestScrub(t *testing.T) {
+	response := fmt.Sprintf(`{"orgId":%q,"key":"TEST-%s","votes":%s,"summary":"Support OAuth login","description":"token t1.2 is fine","iamToken":"secret","nested":[{"token":"secret"}]}`, testOrgID, testOrgID, testOrgID)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, response)
+	}))
+	defer srv.Close()
+
+	path := filepath.Join(t.TempDir(), "scrub.json")
+	rec := newRecorder(t, path, cassette.ModeRecord, cassette.WithScrubber(func(interaction *cassette.Interaction) {
+		interaction.Response.Header.Del("Date")
+	}))
+	res, err := send(t, rec, http.MethodPost, srv.URL+"/v3/orgs/"+testOrgID+"/issues?org="+testOrgID+"&token=abc&page=1234567", testOrgID,
+		"application/json", []byte(fmt.Sprintf(`{"filter":{"org":%q,"queue":"Q%s"}}`, testOrgID, testOrgID)))
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	_ = res.Body.Close()
+	if err := rec.Stop(); err != nil {
+		t.Fatalf("Stop failed: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var saved cassette.Cassette
+	if err := json.Unmarshal(data, &saved); err != nil || len(saved.Interactions) != 1 {
+		t.Fatalf("failed to decode cassette: %v\n%s", err, data)
+	}
+	interaction := saved.Interactions[0]
+	// bodies are compared decoded, so JSON escaping of the file does not hide leaks
+	text := fmt.Sprint(interaction.Request.Path, "?", interaction.Request.Query, interaction.Request.Header, interaction.Response.Header,
+		string(interaction.Request.Body), string(interaction.Response.Body))
+	for _, leaked := range []string{testToken, `"` + testOrgID + `"`, "/" + testOrgID + "/", "org=" + testOrgID, "token=abc", `"secret"`, "Date"} {
+		if strings.Contains(text, leaked) {
+			t.Errorf("cassette contains %q:\n%s", leaked, text)
+		}
+	}
+	for _, kept := range []string{"TEST-" + testOrgID, "Q" + testOrgID, `"votes":` + testOrgID, "page=1234567", "Support OAuth login", "token t1.2 is fine"} {
+		if !strings.Contains(text, kept) {
+			t.Errorf("cassette lost %q:\n%s", kept, text)
+		}
+	}
+
+	// the live request is scrubbed with its own org ID, so the cassette replays in another organization
+	const otherOrgID = "777"
+	rec = newRecorder(t, path, cassette.ModeReplay)
+	res, err = send(t, rec, http.MethodPost, srv.URL+"/v3/orgs/"+otherOrgID+"/issues?org="+otherOrgID+"&token=xyz&page=1234567", otherOrgID,
+		"application/json", []byte(fmt.Sprintf(`{"filter":{"org":%q,"queue":"Q%s"}}`, otherOrgID, testOrgID)))
+	if err != nil {
+		t.Fatalf("replay failed: %v", err)
+	}
+	body, _ := io.ReadAll(res.Body)
+	_ = res.Body.Close()
+	if !strings.Contains(string(body), `"key":"TEST-`+testOrgID+`"`) {
+		t.Errorf("got replayed body %s", body)
+	}
+	if err := rec.Stop(); err != nil {
+		t.Errorf("Stop failed after replay: %v", err)
+	}
+}
+
+func TestReplayNormalizesBodies(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	path := filepath.Join(t.TempDir(), "bodies.json")
+	rec := newRecorder(t, path, cassette.ModeRecord)
+	recordedMultipart, recordedContentType := multipartBody(t, "hello")
+	for _, req := range []struct {
+		contentType string
+		body        []byte
+	}{
+		{"application/json", []byte(`{"a":1,"b":[1,2]}`)},
+		{recordedContentType, recordedMultipart},
+	} {
+		res, err := send(t, rec, http.MethodPost, srv.URL+"/v3/attachments/", testOrgID, req.contentType, req.body)
+		if err != nil {
+			t.Fatalf("request failed: %v", err)
+		}
+		_ = res.Body.Close()
+	}
+	if err := rec.Stop(); err != nil {
+		t.Fatalf("Stop failed: %v", err)
+	}
+
+	rec = newRecorder(t, path, cassette.ModeReplay)
+	replayedMultipart, replayedContentType := multipartBody(t, "hello")
+	if replayedContentType == recordedContentType {
+		t.Fatal("multipart boundaries are expected to differ")
+	}
+	otherMultipart, otherContentType := multipartBody(t, "bye")
+	for _, tt := range []struct {
+		name        string
+		contentType string
+		body        []byte
+		matched     bool
+	}{
+		{"reformatted JSON", "application/json", []byte("{\n  \"b\": [1, 2],\n  \"a\": 1\n}"), true},
+		{"multipart with another boundary", replayedContentType, replayedMultipart, true},
+		{"multipart with another content", otherContentType, otherMultipart, false},
+		{"interaction is used once", "application/json", []byte(`{"a":1,"b":[1,2]}`), false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := send(t, rec, http.MethodPost, srv.URL+"/v3/attachments/", testOrgID, tt.contentType, tt.body)
+			if err == nil {
+				_ = res.Body.Close()
+			}
+			if matched := err == nil; matched != tt.matched {
+				t.Errorf("got error %v, want matched %v", err, tt.matched)
+			}
+		})
+	}
+}
+
+func TestReplayUnmatchedRequest(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "empty.json")
+	if err := os.WriteFile(path, []byte(`{"version":1,"interactions":[]}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	rec := newRecorder(t, path, cassette.ModeReplay)
+	req, err := http.NewRequest(http.MethodGet, "https://api.tracker.yandex.net/v3/myself", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := rec.RoundTrip(req); !errors.Is(err, cassette.ErrNoInteraction) {
+		t.Errorf("got RoundTrip error %v, want ErrNoInteraction", err)
+	}
+	err = rec.Stop()
+	if !errors.Is(err, cassette.ErrNoInteraction) {
+		t.Fatalf("got Stop error %v, want ErrNoInteraction", err)
+	}
+	if !strings.Contains(err.Error(), "GET /v3/myself") {
+		t.Errorf("Stop error does not describe the request: %v", err)
+	}
+}
+
+func TestNewReplayErrors(t *testing.T) {
+	dir := t.TempDir()
+	wrongVersion := filepath.Join(dir, "v2.json")
+	if err := os.WriteFile(wrongVersion, []byte(`{"version":2,"interactions":[]}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	malformed := filepath.Join(dir, "malformed.json")
+	if err := os.WriteFile(malformed, []byte(`{"version":`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range []struct {
+		name string
+		path string
+		want string
+	}{
+		{"wrong version", wrongVersion, "has version 2"},
+		{"malformed file", malformed, "failed to decode cassette"},
+		{"missing file", filepath.Join(dir, "missing.json"), "failed to read cassette"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := cassette.New(tt.path, cassette.ModeReplay)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("got error %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
@@ -2,53 +2,132 @@
 package client
 
 import (
+	"context"
+	"errors"
 	"fmt"
-	"io"
+	"log/slog"
+	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 
 	model "github.com/IndianMax03/yandex-tracker-go-client/model"
+	"go.opentelemetry.io/otel/trace"
 	"resty.dev/v3"
 )
 
 const (
-	baseURL            = "https://api.tracker.yandex.net/v2/"
+	defaultBaseURL     = "https://api.tracker.yandex.net"
+	defaultAPIVersion  = "v2"
 	defaultContentType = "application/json"
 	defaultLang        = "ru"
 	defaultAuthScheme  = "OAuth"
 	defaultPerPage     = 50
+	defaultPerScroll   = 100
 )
 
 // Client is a wrapper over the resty.Client type with Yandex Tracker API-specific headers and a base URL
 type Client struct {
+	// Services grouping the API methods. Consumers can depend on these interfaces to mock the parts they use.
+	Issues      IssuesService
+	Comments    CommentsService
+	Attachments AttachmentsService
+	Links       LinksService
+	Worklogs    WorklogsService
+	Checklists  ChecklistsService
+	Users       UsersService
+	Components  ComponentsService
+	Priorities  PrioritiesService
+
 	restyClient *resty.Client
+	tokenSource TokenSource
+	retryPolicy *RetryPolicy
+	rateLimiter *RateLimiter
+	middlewares []Middleware
+	tracer      trace.Tracer
+	metrics     MetricsCollector
+	logger      *slog.Logger
+	cache       *CacheConfig
+
+	bodyLogLimit int
 }
 
 // New Yandex Tracker Client
 func New(tokenOAuth, xCloudOrgID, xOrgID, acceptLanguage string) *Client {
-	var lang string
-	headers := map[string]string{}
+	opts := defaultOptions()
+	opts.tokenSource = StaticOAuthToken(tokenOAuth)
+	if acceptLanguage == "ru" || acceptLanguage == "en" {
+		opts.lang = acceptLanguage
+	}
+	if xOrgID != "" {
+		opts.orgID = xOrgID
+	} else {
+		opts.cloudOrgID = xCloudOrgID
+	}
+	return newClient(opts)
+}
 
-	if acceptLanguage != "ru" && acceptLanguage != "en" {
-		lang = defaultLang
+// NewWithOptions instantiates Yandex Tracker Client with options.
+// Token and exactly one of org ID and cloud org ID are required.
+//
+//	c, err := client.NewWithOptions(
+//		client.WithOAuthToken(token),
+//		client.WithOrgID(orgID),
+//		client.WithTimeout(10*time.Second),
+//	)
+func NewWithOptions(opts ...Option) (*Client, error) {
+	o := defaultOptions()
+	for _, opt := range opts {
+		if err := opt(o); err != nil {
+			return nil, err
+		}
 	}
-	headers["Accept-Language"] = lang
+	if err := o.validate(); err != nil {
+		return nil, err
+	}
+	return newClient(o), nil
+}
 
-	if xOrgID != "" {
-		headers["X-Org-ID"] = xOrgID
-	} else if xCloudOrgID != "" {
-		headers["X-Cloud-Org-ID"] = xCloudOrgID
+func newClient(opts *options) *Client {
+	headers := map[string]string{}
+	headers["Accept-Language"] = opts.lang
+	if opts.orgID != "" {
+		headers["X-Org-ID"] = opts.orgID
+	} else if opts.cloudOrgID != "" {
+		headers["X-Cloud-Org-ID"] = opts.cloudOrgID
+	}
+	if opts.userAgent != "" {
+		headers["User-Agent"] = opts.userAgent
 	}
 
-	restyClient := resty.New()
+	var restyClient *resty.Client
+	if opts.httpClient != nil {
+		restyClient = resty.NewWithClient(opts.httpClient)
+	} else {
+		restyClient = resty.New()
+	}
+	if opts.timeout > 0 {
+		restyClient.SetTimeout(opts.timeout)
+	}
 	restyClient.SetHeaders(headers)
-	restyClient.SetAuthScheme(defaultAuthScheme)
-	restyClient.SetAuthToken(tokenOAuth)
-	restyClient.SetBaseURL(baseURL)
+	restyClient.OnDebugLog(redactDebugLog)
+	restyClient.SetBaseURL(opts.apiURL())
 
-	return &Client{
+	c := &Client{
 		restyClient: restyClient,
-	}
+		tokenSource: opts.tokenSource,
+		retryPolicy: opts.retryPolicy,
+		rateLimiter: opts.rateLimiter,
+		middlewares: opts.middlewares,
+		tracer:      newTracer(opts.tracerProvider),
+		metrics:     metricsOrNop(opts.metrics),
+		logger:      loggerOrDiscard(opts.logger),
+		cache:       newCacheConfig(opts.cache),
+
+		bodyLogLimit: opts.bodyLogLimit,
+	}
+	c.initServices()
+	return c
 }
 
 // SendRequest sends request to Yandex Tracker
@@ -61,17 +140,39 @@ func (c *Client) SendRequest(
 	requestBody,
 	responseBody any,
 ) (resp *resty.Response, err error) {
-	req := c.restyClient.R().
-		SetContentType(defaultContentType).
-		SetMethod(method).
-		SetBody(requestBody).
-		SetResult(responseBody).
-		SetURL(c.restyClient.BaseURL() + resourceURL).
-		SetQueryParams(queryParams).
-		SetQueryParamsFromValues(multiplyQueryParams).
-		SetPathParams(pathParams)
-	resp, err = req.Send()
-	return
+	return c.SendRequestWithContext(
+		context.Background(),
+		method,
+		resourceURL,
+		queryParams,
+		multiplyQueryParams,
+		pathParams,
+		requestBody,
+		responseBody,
+	)
+}
+
+// SendRequestWithContext is like SendRequest but uses ctx for cancellation and deadlines
+func (c *Client) SendRequestWithContext(
+	ctx context.Context,
+	method,
+	resourceURL string,
+	queryParams map[string]string,
+	multiplyQueryParams url.Values,
+	pathParams map[string]string,
+	requestBody,
+	responseBody any,
+) (resp *resty.Response, err error) {
+	return c.handle(ctx, &Request{
+		Method:              method,
+		ResourceURL:         resourceURL,
+		QueryParams:         queryParams,
+		MultiplyQueryParams: multiplyQueryParams,
+		PathParams:          pathParams,
+		Header:              http.Header{},
+		Body:                requestBody,
+		Result:              responseBody,
+	})
 }
 
 // SendMultipartRequest sends multipart request to Yandex Tracker
@@ -84,29 +185,91 @@ func (c *Client) SendMultipartRequest(
 	requestBody *resty.MultipartField,
 	responseBody any,
 ) (resp *resty.Response, err error) {
-	req := c.restyClient.R().
-		SetContentType(defaultContentType).
-		SetMethod(method).
-		SetMultipartFields(requestBody).
-		SetResult(responseBody).
-		SetURL(c.restyClient.BaseURL() + resourceURL).
-		SetQueryParams(queryParams).
-		SetQueryParamsFromValues(multiplyQueryParams).
-		SetPathParams(pathParams)
+	return c.SendMultipartRequestWithContext(
+		context.Background(),
+		method,
+		resourceURL,
+		queryParams,
+		multiplyQueryParams,
+		pathParams,
+		requestBody,
+		responseBody,
+	)
+}
+
+// SendMultipartRequestWithContext is like SendMultipartRequest but uses ctx for cancellation and deadlines
+func (c *Client) SendMultipartRequestWithContext(
+	ctx context.Context,
+	method,
+	resourceURL string,
+	queryParams map[string]string,
+	multiplyQueryParams url.Values,
+	pathParams map[string]string,
+	requestBody *resty.MultipartField,
+	responseBody any,
+) (resp *resty.Response, err error) {
+	return c.handle(ctx, &Request{
+		Method:              method,
+		ResourceURL:         resourceURL,
+		QueryParams:         queryParams,
+		MultiplyQueryParams: multiplyQueryParams,
+		PathParams:          pathParams,
+		Header:              http.Header{},
+		Multipart:           requestBody,
+		Result:              responseBody,
+	})
+}
 
-	resp, err = req.Send()
-	return
+// newRestyRequest builds resty request for a single attempt
+func (c *Client) newRestyRequest(ctx context.Context, req *Request) *resty.Request {
+	r := c.restyClient.R().
+		SetContext(ctx).
+		SetContentType(defaultContentType).
+		SetMethod(req.Method).
+		SetResult(req.Result).
+		SetURL(c.restyClient.BaseURL() + req.ResourceURL).
+		SetQueryParams(req.QueryParams).
+		SetQueryParamsFromValues(req.MultiplyQueryParams).
+		SetPathParams(req.PathParams).
+		SetHeaderMultiValues(req.Header).
+		SetDoNotParseResponse(req.stream)
+	if req.Multipart != nil {
+		return r.SetMultipartFields(req.Multipart)
+	}
+	return r.SetBody(req.Body)
 }
 
-// SetDebug allows logging of details of each request and response.
+// SetDebug allows logging of details of each request and response. Authorization header and tokens
+// in bodies are redacted. Prefer SetLogger for structured logging.
 func (c *Client) SetDebug(debug bool) {
 	c.restyClient.SetDebug(debug)
 }
 
+// SetTokenSource sets the source of tokens used to authorize requests
+func (c *Client) SetTokenSource(tokenSource TokenSource) {
+	c.tokenSource = tokenSource
+}
+
+// SetRateLimiter sets the limiter every request waits on. Nil limiter disables limiting.
+func (c *Client) SetRateLimiter(limiter *RateLimiter) {
+	c.rateLimiter = limiter
+}
+
+// SetRetryPolicy sets the policy of retrying failed requests. Nil policy disables retries.
+func (c *Client) SetRetryPolicy(policy *RetryPolicy) {
+	c.retryPolicy = policy
+}
+
 // CreateIssue sends request to create new issue in Yandex Tracker
 func (c *Client) CreateIssue(req *model.IssueCreateRequest) (*model.IssueResponse, error) {
+	return c.CreateIssueWithContext(context.Background(), req)
+}
+
+// CreateIssueWithContext is like CreateIssue but uses ctx for cancellation and deadlines
+func (c *Client) CreateIssueWithContext(ctx context.Context, req *model.IssueCreateRequest) (*model.IssueResponse, error) {
 	var respBody model.IssueResponse
-	res, err := c.SendRequest(
+	res, err := c.SendRequestWithContext(
+		ctx,
 		resty.MethodPost,
 		issuesCreateURL,
 		nil,
@@ -119,14 +282,18 @@ func (c *Client) CreateIssue(req *model.IssueCreateRequest) (*model.IssueRespons
 		return nil, err
 	}
 	if res.IsError() {
-		body, _ := io.ReadAll(res.Body)
-		return nil, fmt.Errorf("request failed with status code: %s. body: %s", res.Status(), body)
+		return nil, newAPIError(res)
 	}
 	return &respBody, nil
 }
 
 // GetIssue sends request to find concrete issue by ID
 func (c *Client) GetIssue(issueID string, includeAttachments, includeTransitions bool) (*model.IssueResponse, error) {
+	return c.GetIssueWithContext(context.Background(), issueID, includeAttachments, includeTransitions)
+}
+
+// GetIssueWithContext is like GetIssue but uses ctx for cancellation and deadlines
+func (c *Client) GetIssueWithContext(ctx context.Context, issueID string, includeAttachments, includeTransitions bool) (*model.IssueResponse, error) {
 	var respBody model.IssueResponse
 	pathParams := make(map[string]string)
 	pathParams["issue_id"] = issueID
@@ -143,7 +310,8 @@ func (c *Client) GetIssue(issueID string, includeAttachments, includeTransitions
 			"expand": values,
 		}
 	}
-	res, err := c.SendRequest(
+	res, err := c.SendRequestWithContext(
+		ctx,
 		resty.MethodGet,
 		issuesGetURL,
 		nil,
@@ -156,16 +324,21 @@ func (c *Client) GetIssue(issueID string, includeAttachments, includeTransitions
 		return nil, err
 	}
 	if res.IsError() {
-		body, _ := io.ReadAll(res.Body)
-		return nil, fmt.Errorf("request failed with status code: %s. body: %s", res.Status(), body)
+		return nil, newAPIError(res)
 	}
 	return &respBody, nil
 }
 
 // GetIssuesCount sends a request to get the number of tasks
 func (c *Client) GetIssuesCount(req *model.IssueCountRequest) (int, error) {
+	return c.GetIssuesCountWithContext(context.Background(), req)
+}
+
+// GetIssuesCountWithContext is like GetIssuesCount but uses ctx for cancellation and deadlines
+func (c *Client) GetIssuesCountWithContext(ctx context.Context, req *model.IssueCountRequest) (int, error) {
 	var respBody model.IssueCountResponse
-	res, err := c.SendRequest(
+	res, err := c.SendRequestWithContext(
+		ctx,
 		resty.MethodPost,
 		issuesCountURL,
 		nil,
@@ -178,14 +351,18 @@ func (c *Client) GetIssuesCount(req *model.IssueCountRequest) (int, error) {
 		return 0, err
 	}
 	if res.IsError() {
-		body, _ := io.ReadAll(res.Body)
-		return 0, fmt.Errorf("request failed with status code: %s. body: %s", res.Status(), body)
+		return 0, newAPIError(res)
 	}
 	return int(respBody), nil
 }
 
 // SearchIssuesPage sends a request to find issues using pagination
 func (c *Client) SearchIssuesPage(req *model.IssueSearchRequest, pageReq *model.PageRequest) ([]model.IssueResponse, *model.PageResponse, error) {
+	return c.SearchIssuesPageWithContext(context.Background(), req, pageReq)
+}
+
+// SearchIssuesPageWithContext is like SearchIssuesPage but uses ctx for cancellation and deadlines
+func (c *Client) SearchIssuesPageWithContext(ctx context.Context, req *model.IssueSearchRequest, pageReq *model.PageRequest) ([]model.IssueResponse, *model.PageResponse, error) {
 	if pageReq.PerPage <= 0 {
 		pageReq.PerPage = 5
 	}
@@ -197,7 +374,8 @@ func (c *Client) SearchIssuesPage(req *model.IssueSearchRequest, pageReq *model.
 	queryParams["page"] = strconv.Itoa(pageReq.Page)
 
 	var respBody []model.IssueResponse
-	res, err := c.SendRequest(
+	res, err := c.SendRequestWithContext(
+		ctx,
 		resty.MethodPost,
 		issuesSearchURL,
 		queryParams,
@@ -210,8 +388,7 @@ func (c *Client) SearchIssuesPage(req *model.IssueSearchRequest, pageReq *model.
 		return nil, nil, err
 	}
 	if res.IsError() {
-		body, _ := io.ReadAll(res.Body)
-		return nil, nil, fmt.Errorf("request failed with status code: %s. body: %s", res.Status(), body)
+		return nil, nil, newAPIError(res)
 	}
 
 	totalPages, _ := strconv.Atoi(res.Header().Get("X-Total-Pages"))
@@ -223,34 +400,112 @@ func (c *Client) SearchIssuesPage(req *model.IssueSearchRequest, pageReq *model.
 	return respBody, &pageResp, nil
 }
 
-// SearchAllIssues sends a request to find all issues
-func (c *Client) SearchAllIssues(req *model.IssueSearchRequest) ([]model.IssueResponse, error) {
-	currentPage := 1
-	pageReq := model.PageRequest{
-		Page:    currentPage,
-		PerPage: defaultPerPage,
+// SearchIssuesScroll sends a request to find issues using scrolling.
+// Empty scrollID starts a new scroll, otherwise the next portion of the scroll is requested.
+func (c *Client) SearchIssuesScroll(req *model.IssueSearchRequest, scrollReq *model.ScrollRequest, scrollID string) ([]model.IssueResponse, *model.ScrollResponse, error) {
+	return c.SearchIssuesScrollWithContext(context.Background(), req, scrollReq, scrollID)
+}
+
+// SearchIssuesScrollWithContext is like SearchIssuesScroll but uses ctx for cancellation and deadlines
+func (c *Client) SearchIssuesScrollWithContext(ctx context.Context, req *model.IssueSearchRequest, scrollReq *model.ScrollRequest, scrollID string) ([]model.IssueResponse, *model.ScrollResponse, error) {
+	if scrollReq == nil {
+		scrollReq = &model.ScrollRequest{}
+	}
+	queryParams := make(map[string]string)
+	if scrollReq.ScrollTTLMillis > 0 {
+		queryParams["scrollTTLMillis"] = strconv.Itoa(scrollReq.ScrollTTLMillis)
+	}
+	if scrollID != "" {
+		queryParams["scrollId"] = scrollID
+	} else {
+		if scrollReq.ScrollType == "" {
+			scrollReq.ScrollType = model.ScrollTypeUnsorted
+		}
+		if scrollReq.PerScroll <= 0 {
+			scrollReq.PerScroll = defaultPerScroll
+		}
+		queryParams["scrollType"] = scrollReq.ScrollType
+		queryParams["perScroll"] = strconv.Itoa(scrollReq.PerScroll)
 	}
 
-	result, pag, err := c.SearchIssuesPage(req, &pageReq)
+	var respBody []model.IssueResponse
+	res, err := c.SendRequestWithContext(
+		ctx,
+		resty.MethodPost,
+		issuesSearchURL,
+		queryParams,
+		nil,
+		nil,
+		req,
+		&respBody,
+	)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+	if res.IsError() {
+		return nil, nil, newAPIError(res)
 	}
-	totalPages := pag.TotalPages
-	for currentPage < totalPages {
-		currentPage++
-		pageReq.Page = currentPage
-		resp, _, _ := c.SearchIssuesPage(req, &pageReq)
-		result = append(result, resp...)
+
+	totalCount, _ := strconv.Atoi(res.Header().Get("X-Total-Count"))
+	scrollResp := model.ScrollResponse{
+		ScrollID:    res.Header().Get("X-Scroll-Id"),
+		ScrollToken: res.Header().Get("X-Scroll-Token"),
+		TotalCount:  totalCount,
 	}
-	return result, nil
+	return respBody, &scrollResp, nil
+}
+
+// ClearScroll sends a request to release scroll contexts (scroll ID -> scroll token)
+func (c *Client) ClearScroll(scrolls map[string]string) error {
+	return c.ClearScrollWithContext(context.Background(), scrolls)
+}
+
+// ClearScrollWithContext is like ClearScroll but uses ctx for cancellation and deadlines
+func (c *Client) ClearScrollWithContext(ctx context.Context, scrolls map[string]string) error {
+	res, err := c.SendRequestWithContext(
+		ctx,
+		resty.MethodPost,
+		scrollClearURL,
+		nil,
+		nil,
+		nil,
+		scrolls,
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+	if res.IsError() {
+		return newAPIError(res)
+	}
+	return nil
+}
+
+// SearchAllIssues sends a request to find all issues.
+// Objects from the pages that succeeded are returned with *PartialResultError if a later page fails.
+func (c *Client) SearchAllIssues(req *model.IssueSearchRequest, opts ...PaginationOption) ([]model.IssueResponse, error) {
+	return c.SearchAllIssuesWithContext(context.Background(), req, opts...)
+}
+
+// SearchAllIssuesWithContext is like SearchAllIssues but uses ctx for cancellation and deadlines
+func (c *Client) SearchAllIssuesWithContext(ctx context.Context, req *model.IssueSearchRequest, opts ...PaginationOption) ([]model.IssueResponse, error) {
+	return collectPages(ctx, c, "SearchAllIssues", func(ctx context.Context, pageReq *model.PageRequest) ([]model.IssueResponse, *model.PageResponse, error) {
+		return c.SearchIssuesPageWithContext(ctx, req, pageReq)
+	}, opts)
 }
 
 // ModifyIssue sends a request to modify existing issue
 func (c *Client) ModifyIssue(issueID string, req *model.IssueModifyRequest) (*model.IssueResponse, error) {
+	return c.ModifyIssueWithContext(context.Background(), issueID, req)
+}
+
+// ModifyIssueWithContext is like ModifyIssue but uses ctx for cancellation and deadlines
+func (c *Client) ModifyIssueWithContext(ctx context.Context, issueID string, req *model.IssueModifyRequest) (*model.IssueResponse, error) {
 	pathParams := make(map[string]string)
 	pathParams["issue_id"] = issueID
 	var respBody model.IssueResponse
-	res, err := c.SendRequest(
+	res, err := c.SendRequestWithContext(
+		ctx,
 		resty.MethodPatch,
 		issuesModifyURL,
 		nil,
@@ -263,19 +518,24 @@ func (c *Client) ModifyIssue(issueID string, req *model.IssueModifyRequest) (*mo
 		return nil, err
 	}
 	if res.IsError() {
-		body, _ := io.ReadAll(res.Body)
-		return nil, fmt.Errorf("request failed with status code: %s. body: %s", res.Status(), body)
+		return nil, newAPIError(res)
 	}
 	return &respBody, nil
 }
 
 // ModifyIssueStatus sends a request to modify existing issue status
 func (c *Client) ModifyIssueStatus(issueID string, transitionID string, req *model.IssueModifyStatusRequest) ([]model.IssueModifyStatusResponse, error) {
+	return c.ModifyIssueStatusWithContext(context.Background(), issueID, transitionID, req)
+}
+
+// ModifyIssueStatusWithContext is like ModifyIssueStatus but uses ctx for cancellation and deadlines
+func (c *Client) ModifyIssueStatusWithContext(ctx context.Context, issueID string, transitionID string, req *model.IssueModifyStatusRequest) ([]model.IssueModifyStatusResponse, error) {
 	pathParams := make(map[string]string)
 	pathParams["issue_id"] = issueID
 	pathParams["transition_id"] = transitionID
 	var respBody []model.IssueModifyStatusResponse
-	res, err := c.SendRequest(
+	res, err := c.SendRequestWithContext(
+		ctx,
 		resty.MethodPost,
 		issuesModifyStatusURL,
 		nil,
@@ -288,18 +548,23 @@ func (c *Client) ModifyIssueStatus(issueID string, transitionID string, req *mod
 		return nil, err
 	}
 	if res.IsError() {
-		body, _ := io.ReadAll(res.Body)
-		return nil, fmt.Errorf("request failed with status code: %s. body: %s", res.Status(), body)
+		return nil, newAPIError(res)
 	}
 	return respBody, nil
 }
 
 // GetIssueTransitions sends a request to find all possible issue transitions
 func (c *Client) GetIssueTransitions(issueID string) ([]model.IssueTransitionsResponse, error) {
+	return c.GetIssueTransitionsWithContext(context.Background(), issueID)
+}
+
+// GetIssueTransitionsWithContext is like GetIssueTransitions but uses ctx for cancellation and deadlines
+func (c *Client) GetIssueTransitionsWithContext(ctx context.Context, issueID string) ([]model.IssueTransitionsResponse, error) {
 	pathParams := make(map[string]string)
 	pathParams["issue_id"] = issueID
 	var respBody []model.IssueTransitionsResponse
-	res, err := c.SendRequest(
+	res, err := c.SendRequestWithContext(
+		ctx,
 		resty.MethodGet,
 		issueGetTransitionsURL,
 		nil,
@@ -312,14 +577,18 @@ func (c *Client) GetIssueTransitions(issueID string) ([]model.IssueTransitionsRe
 		return nil, err
 	}
 	if res.IsError() {
-		body, _ := io.ReadAll(res.Body)
-		return nil, fmt.Errorf("request failed with status code: %s. body: %s", res.Status(), body)
+		return nil, newAPIError(res)
 	}
 	return respBody, nil
 }
 
 // GetPrioritiesPage sends a request to find priorities using pagination
 func (c *Client) GetPrioritiesPage(localized bool, pageReq *model.PageRequest) ([]model.PriorityResponse, *model.PageResponse, error) {
+	return c.GetPrioritiesPageWithContext(context.Background(), localized, pageReq)
+}
+
+// GetPrioritiesPageWithContext is like GetPrioritiesPage but uses ctx for cancellation and deadlines
+func (c *Client) GetPrioritiesPageWithContext(ctx context.Context, localized bool, pageReq *model.PageRequest) ([]model.PriorityResponse, *model.PageResponse, error) {
 	if pageReq.PerPage <= 0 {
 		pageReq.PerPage = 5
 	}
@@ -333,7 +602,8 @@ func (c *Client) GetPrioritiesPage(localized bool, pageReq *model.PageRequest) (
 	queryParams["localized"] = strconv.FormatBool(localized)
 	var respBody []model.PriorityResponse
 
-	res, err := c.SendRequest(
+	res, err := c.SendRequestWithContext(
+		ctx,
 		resty.MethodGet,
 		prioritiesGetURL,
 		queryParams,
@@ -346,8 +616,7 @@ func (c *Client) GetPrioritiesPage(localized bool, pageReq *model.PageRequest) (
 		return nil, nil, err
 	}
 	if res.IsError() {
-		body, _ := io.ReadAll(res.Body)
-		return nil, nil, fmt.Errorf("request failed with status code: %s. body: %s", res.Status(), body)
+		return nil, nil, newAPIError(res)
 	}
 	totalPages, _ := strconv.Atoi(res.Header().Get("X-Total-Pages"))
 	totalCount, _ := strconv.Atoi(res.Header().Get("X-Total-Count"))
@@ -358,30 +627,26 @@ func (c *Client) GetPrioritiesPage(localized bool, pageReq *model.PageRequest) (
 	return respBody, &pageResp, nil
 }
 
-// GetAllPriorities sends a request to find all priorities
-func (c *Client) GetAllPriorities(localized bool) ([]model.PriorityResponse, error) {
-	currentPage := 1
-	pageReq := model.PageRequest{
-		Page:    currentPage,
-		PerPage: defaultPerPage,
-	}
+// GetAllPriorities sends a request to find all priorities.
+// Objects from the pages that succeeded are returned with *PartialResultError if a later page fails.
+func (c *Client) GetAllPriorities(localized bool, opts ...PaginationOption) ([]model.PriorityResponse, error) {
+	return c.GetAllPrioritiesWithContext(context.Background(), localized, opts...)
+}
 
-	result, pag, err := c.GetPrioritiesPage(localized, &pageReq)
-	if err != nil {
-		return nil, err
-	}
-	totalPages := pag.TotalPages
-	for currentPage < totalPages {
-		currentPage++
-		pageReq.Page = currentPage
-		resp, _, _ := c.GetPrioritiesPage(localized, &pageReq)
-		result = append(result, resp...)
-	}
-	return result, nil
+// GetAllPrioritiesWithContext is like GetAllPriorities but uses ctx for cancellation and deadlines
+func (c *Client) GetAllPrioritiesWithContext(ctx context.Context, localized bool, opts ...PaginationOption) ([]model.PriorityResponse, error) {
+	return collectPages(ctx, c, "GetAllPriorities", func(ctx context.Context, pageReq *model.PageRequest) ([]model.PriorityResponse, *model.PageResponse, error) {
+		return c.GetPrioritiesPageWithContext(ctx, localized, pageReq)
+	}, opts)
 }
 
 // GetPriority sends a request to find concrete priority
 func (c *Client) GetPriority(priorityID int, localized bool) (*model.PriorityResponse, error) {
+	return c.GetPriorityWithContext(context.Background(), priorityID, localized)
+}
+
+// GetPriorityWithContext is like GetPriority but uses ctx for cancellation and deadlines
+func (c *Client) GetPriorityWithContext(ctx context.Context, priorityID int, localized bool) (*model.PriorityResponse, error) {
 	queryParams := make(map[string]string)
 	queryParams["localized"] = strconv.FormatBool(localized)
 	pathParams := make(map[string]string)
@@ -389,7 +654,8 @@ func (c *Client) GetPriority(priorityID int, localized bool) (*model.PriorityRes
 
 	var respBody model.PriorityResponse
 
-	res, err := c.SendRequest(
+	res, err := c.SendRequestWithContext(
+		ctx,
 		resty.MethodGet,
 		priorityGetURL,
 		queryParams,
@@ -402,18 +668,23 @@ func (c *Client) GetPriority(priorityID int, localized bool) (*model.PriorityRes
 		return nil, err
 	}
 	if res.IsError() {
-		body, _ := io.ReadAll(res.Body)
-		return nil, fmt.Errorf("request failed with status code: %s. body: %s", res.Status(), body)
+		return nil, newAPIError(res)
 	}
 	return &respBody, nil
 }
 
 // CreateComment sends a request to add a comment to a issue
 func (c *Client) CreateComment(issueID string, req *model.CommentRequest) (*model.CommentResponse, error) {
+	return c.CreateCommentWithContext(context.Background(), issueID, req)
+}
+
+// CreateCommentWithContext is like CreateComment but uses ctx for cancellation and deadlines
+func (c *Client) CreateCommentWithContext(ctx context.Context, issueID string, req *model.CommentRequest) (*model.CommentResponse, error) {
 	pathParams := make(map[string]string)
 	pathParams["issue_id"] = issueID
 	var respBody model.CommentResponse
-	res, err := c.SendRequest(
+	res, err := c.SendRequestWithContext(
+		ctx,
 		resty.MethodPost,
 		issueAppendCommentURL,
 		nil,
@@ -426,20 +697,25 @@ func (c *Client) CreateComment(issueID string, req *model.CommentRequest) (*mode
 		return nil, err
 	}
 	if res.IsError() {
-		body, _ := io.ReadAll(res.Body)
-		return nil, fmt.Errorf("request failed with status code: %s. body: %s", res.Status(), body)
+		return nil, newAPIError(res)
 	}
 	return &respBody, nil
 }
 
 // GetComment sends a request to get concrete comment to a issue
 func (c *Client) GetComment(issueID string, commentID int) (*model.CommentResponse, error) {
+	return c.GetCommentWithContext(context.Background(), issueID, commentID)
+}
+
+// GetCommentWithContext is like GetComment but uses ctx for cancellation and deadlines
+func (c *Client) GetCommentWithContext(ctx context.Context, issueID string, commentID int) (*model.CommentResponse, error) {
 	pathParams := make(map[string]string)
 	pathParams["issue_id"] = issueID
 	commentStringID := strconv.Itoa(commentID)
 	pathParams["comment_id"] = commentStringID
 	var respBody model.CommentResponse
-	res, err := c.SendRequest(
+	res, err := c.SendRequestWithContext(
+		ctx,
 		resty.MethodGet,
 		issueGetCommentURL,
 		nil,
@@ -452,14 +728,18 @@ func (c *Client) GetComment(issueID string, commentID int) (*model.CommentRespon
 		return nil, err
 	}
 	if res.IsError() {
-		body, _ := io.ReadAll(res.Body)
-		return nil, fmt.Errorf("request failed with status code: %s. body: %s", res.Status(), body)
+		return nil, newAPIError(res)
 	}
 	return &respBody, nil
 }
 
 // GetXCommentsAfterY sends a request to get first X comments after comment with ID=Y (model.PageRequest.PerPage = X, model.PageRequest.FromID = Y)
 func (c *Client) GetXCommentsAfterY(issueID string, commentExpand string, pageReq *model.PageRequest) ([]model.CommentResponse, *model.PageResponse, error) {
+	return c.GetXCommentsAfterYWithContext(context.Background(), issueID, commentExpand, pageReq)
+}
+
+// GetXCommentsAfterYWithContext is like GetXCommentsAfterY but uses ctx for cancellation and deadlines
+func (c *Client) GetXCommentsAfterYWithContext(ctx context.Context, issueID string, commentExpand string, pageReq *model.PageRequest) ([]model.CommentResponse, *model.PageResponse, error) {
 	if pageReq.PerPage <= 0 {
 		pageReq.PerPage = defaultPerPage
 	}
@@ -476,7 +756,8 @@ func (c *Client) GetXCommentsAfterY(issueID string, commentExpand string, pageRe
 	pathParams := make(map[string]string)
 	pathParams["issue_id"] = issueID
 	var respBody []model.CommentResponse
-	res, err := c.SendRequest(
+	res, err := c.SendRequestWithContext(
+		ctx,
 		resty.MethodGet,
 		issueGetCommentsURL,
 		queryParams,
@@ -489,8 +770,7 @@ func (c *Client) GetXCommentsAfterY(issueID string, commentExpand string, pageRe
 		return nil, nil, err
 	}
 	if res.IsError() {
-		body, _ := io.ReadAll(res.Body)
-		return nil, nil, fmt.Errorf("request failed with status code: %s. body: %s", res.Status(), body)
+		return nil, nil, newAPIError(res)
 	}
 	totalPages, _ := strconv.Atoi(res.Header().Get("X-Total-Pages"))
 	totalCount, _ := strconv.Atoi(res.Header().Get("X-Total-Count"))
@@ -506,34 +786,33 @@ func (c *Client) GetXCommentsAfterY(issueID string, commentExpand string, pageRe
 	return respBody, &pageResp, nil
 }
 
-// GetCommentsAll sends requests to get all of the comments using default perPage size
+// GetCommentsAll sends requests to get all of the comments using default perPage size.
+// Comments collected before a failed page are returned with *PartialResultError.
 func (c *Client) GetCommentsAll(issueID string, commentExpand string) ([]model.CommentResponse, error) {
-	pageReq := model.PageRequest{
-		PerPage: defaultPerPage,
-	}
+	return c.GetCommentsAllWithContext(context.Background(), issueID, commentExpand)
+}
 
-	result, pag, err := c.GetXCommentsAfterY(issueID, commentExpand, &pageReq)
-	if err != nil {
-		return nil, err
-	}
-	fromID := pag.LastID
-	for fromID > 0 {
-		pageReq.FromID = fromID
-		resp, pagResp, _ := c.GetXCommentsAfterY(issueID, commentExpand, &pageReq)
-		fromID = pagResp.LastID
-		result = append(result, resp...)
-	}
-	return result, nil
+// GetCommentsAllWithContext is like GetCommentsAll but uses ctx for cancellation and deadlines
+func (c *Client) GetCommentsAllWithContext(ctx context.Context, issueID string, commentExpand string) ([]model.CommentResponse, error) {
+	return collectFromID(ctx, c, "GetCommentsAll", func(ctx context.Context, pageReq *model.PageRequest) ([]model.CommentResponse, *model.PageResponse, error) {
+		return c.GetXCommentsAfterYWithContext(ctx, issueID, commentExpand, pageReq)
+	})
 }
 
 // UpdateComment sends a request to update a comment to a issue
 func (c *Client) UpdateComment(issueID string, commentID int, req *model.CommentUpdateRequest) (*model.CommentResponse, error) {
+	return c.UpdateCommentWithContext(context.Background(), issueID, commentID, req)
+}
+
+// UpdateCommentWithContext is like UpdateComment but uses ctx for cancellation and deadlines
+func (c *Client) UpdateCommentWithContext(ctx context.Context, issueID string, commentID int, req *model.CommentUpdateRequest) (*model.CommentResponse, error) {
 	pathParams := make(map[string]string)
 	pathParams["issue_id"] = issueID
 	commentStringID := strconv.Itoa(commentID)
 	pathParams["comment_id"] = commentStringID
 	var respBody model.CommentResponse
-	res, err := c.SendRequest(
+	res, err := c.SendRequestWithContext(
+		ctx,
 		resty.MethodPatch,
 		issueUpdateCommentURL,
 		nil,
@@ -546,20 +825,25 @@ func (c *Client) UpdateComment(issueID string, commentID int, req *model.Comment
 		return nil, err
 	}
 	if res.IsError() {
-		body, _ := io.ReadAll(res.Body)
-		return nil, fmt.Errorf("request failed with status code: %s. body: %s", res.Status(), body)
+		return nil, newAPIError(res)
 	}
 	return &respBody, nil
 }
 
 // DeleteComment sends a request to delete a comment to a issue
 func (c *Client) DeleteComment(issueID string, commentID int) error {
+	return c.DeleteCommentWithContext(context.Background(), issueID, commentID)
+}
+
+// DeleteCommentWithContext is like DeleteComment but uses ctx for cancellation and deadlines
+func (c *Client) DeleteCommentWithContext(ctx context.Context, issueID string, commentID int) error {
 	pathParams := make(map[string]string)
 	pathParams["issue_id"] = issueID
 	commentStringID := strconv.Itoa(commentID)
 	pathParams["comment_id"] = commentStringID
 	var respBody model.CommentResponse
-	res, err := c.SendRequest(
+	res, err := c.SendRequestWithContext(
+		ctx,
 		resty.MethodDelete,
 		issueDeleteCommentURL,
 		nil,
@@ -572,16 +856,21 @@ func (c *Client) DeleteComment(issueID string, commentID int) error {
 		return err
 	}
 	if res.IsError() {
-		body, _ := io.ReadAll(res.Body)
-		return fmt.Errorf("request failed with status code: %s. body: %s", res.Status(), body)
+		return newAPIError(res)
 	}
 	return nil
 }
 
 // GetMyself sends request to get information about the user account on whose behalf the API call is made.
 func (c *Client) GetMyself() (*model.UserResponse, error) {
+	return c.GetMyselfWithContext(context.Background())
+}
+
+// GetMyselfWithContext is like GetMyself but uses ctx for cancellation and deadlines
+func (c *Client) GetMyselfWithContext(ctx context.Context) (*model.UserResponse, error) {
 	var respBody model.UserResponse
-	res, err := c.SendRequest(
+	res, err := c.SendRequestWithContext(
+		ctx,
 		resty.MethodGet,
 		myselfURL,
 		nil,
@@ -594,14 +883,18 @@ func (c *Client) GetMyself() (*model.UserResponse, error) {
 		return nil, err
 	}
 	if res.IsError() {
-		body, _ := io.ReadAll(res.Body)
-		return nil, fmt.Errorf("request failed with status code: %s. body: %s", res.Status(), body)
+		return nil, newAPIError(res)
 	}
 	return &respBody, nil
 }
 
 // GetUsersPage sends a request to find users using pagination
 func (c *Client) GetUsersPage(pageReq *model.PageRequest) ([]model.UserResponse, *model.PageResponse, error) {
+	return c.GetUsersPageWithContext(context.Background(), pageReq)
+}
+
+// GetUsersPageWithContext is like GetUsersPage but uses ctx for cancellation and deadlines
+func (c *Client) GetUsersPageWithContext(ctx context.Context, pageReq *model.PageRequest) ([]model.UserResponse, *model.PageResponse, error) {
 	if pageReq.PerPage <= 0 {
 		pageReq.PerPage = 5
 	}
@@ -613,7 +906,8 @@ func (c *Client) GetUsersPage(pageReq *model.PageRequest) ([]model.UserResponse,
 	queryParams["page"] = strconv.Itoa(pageReq.Page)
 
 	var respBody []model.UserResponse
-	res, err := c.SendRequest(
+	res, err := c.SendRequestWithContext(
+		ctx,
 		resty.MethodGet,
 		usersGetURL,
 		queryParams,
@@ -626,8 +920,7 @@ func (c *Client) GetUsersPage(pageReq *model.PageRequest) ([]model.UserResponse,
 		return nil, nil, err
 	}
 	if res.IsError() {
-		body, _ := io.ReadAll(res.Body)
-		return nil, nil, fmt.Errorf("request failed with status code: %s. body: %s", res.Status(), body)
+		return nil, nil, newAPIError(res)
 	}
 
 	totalPages, _ := strconv.Atoi(res.Header().Get("X-Total-Pages"))
@@ -639,30 +932,24 @@ func (c *Client) GetUsersPage(pageReq *model.PageRequest) ([]model.UserResponse,
 	return respBody, &pageResp, nil
 }
 
-// GetUsersAll sends a request to find all users
-func (c *Client) GetUsersAll() ([]model.UserResponse, error) {
-	currentPage := 1
-	pageReq := model.PageRequest{
-		Page:    currentPage,
-		PerPage: defaultPerPage,
-	}
+// GetUsersAll sends a request to find all users.
+// Objects from the pages that succeeded are returned with *PartialResultError if a later page fails.
+func (c *Client) GetUsersAll(opts ...PaginationOption) ([]model.UserResponse, error) {
+	return c.GetUsersAllWithContext(context.Background(), opts...)
+}
 
-	result, pag, err := c.GetUsersPage(&pageReq)
-	if err != nil {
-		return nil, err
-	}
-	totalPages := pag.TotalPages
-	for currentPage < totalPages {
-		currentPage++
-		pageReq.Page = currentPage
-		resp, _, _ := c.GetUsersPage(&pageReq)
-		result = append(result, resp...)
-	}
-	return result, nil
+// GetUsersAllWithContext is like GetUsersAll but uses ctx for cancellation and deadlines
+func (c *Client) GetUsersAllWithContext(ctx context.Context, opts ...PaginationOption) ([]model.UserResponse, error) {
+	return collectPages(ctx, c, "GetUsersAll", c.GetUsersPageWithContext, opts)
 }
 
 // GetUser sends request to get information about concrete user (login is a priority).
 func (c *Client) GetUser(login string, userID int) (*model.UserResponse, error) {
+	return c.GetUserWithContext(context.Background(), login, userID)
+}
+
+// GetUserWithContext is like GetUser but uses ctx for cancellation and deadlines
+func (c *Client) GetUserWithContext(ctx context.Context, login string, userID int) (*model.UserResponse, error) {
 	pathParams := make(map[string]string)
 	if login != "" {
 		allNums := true
@@ -682,7 +969,8 @@ func (c *Client) GetUser(login string, userID int) (*model.UserResponse, error)
 		pathParams["login_or_user_id"] = strconv.Itoa(userID)
 	}
 	var respBody model.UserResponse
-	res, err := c.SendRequest(
+	res, err := c.SendRequestWithContext(
+		ctx,
 		resty.MethodGet,
 		userGetURL,
 		nil,
@@ -695,16 +983,21 @@ func (c *Client) GetUser(login string, userID int) (*model.UserResponse, error)
 		return nil, err
 	}
 	if res.IsError() {
-		body, _ := io.ReadAll(res.Body)
-		return nil, fmt.Errorf("request failed with status code: %s. body: %s", res.Status(), body)
+		return nil, newAPIError(res)
 	}
 	return &respBody, nil
 }
 
 // CreateComponent sends a request to create a component to a queue
 func (c *Client) CreateComponent(req *model.ComponentRequest) (*model.ComponentResponse, error) {
+	return c.CreateComponentWithContext(context.Background(), req)
+}
+
+// CreateComponentWithContext is like CreateComponent but uses ctx for cancellation and deadlines
+func (c *Client) CreateComponentWithContext(ctx context.Context, req *model.ComponentRequest) (*model.ComponentResponse, error) {
 	var respBody model.ComponentResponse
-	res, err := c.SendRequest(
+	res, err := c.SendRequestWithContext(
+		ctx,
 		resty.MethodPost,
 		componentCreateURL,
 		nil,
@@ -717,14 +1010,18 @@ func (c *Client) CreateComponent(req *model.ComponentRequest) (*model.ComponentR
 		return nil, err
 	}
 	if res.IsError() {
-		body, _ := io.ReadAll(res.Body)
-		return nil, fmt.Errorf("request failed with status code: %s. body: %s", res.Status(), body)
+		return nil, newAPIError(res)
 	}
 	return &respBody, nil
 }
 
 // UpdateComponent sends a request to update a component to a queue
 func (c *Client) UpdateComponent(componentID, componentVersion int, req *model.ComponentUpdateRequest) (*model.ComponentResponse, error) {
+	return c.UpdateComponentWithContext(context.Background(), componentID, componentVersion, req)
+}
+
+// UpdateComponentWithContext is like UpdateComponent but uses ctx for cancellation and deadlines
+func (c *Client) UpdateComponentWithContext(ctx context.Context, componentID, componentVersion int, req *model.ComponentUpdateRequest) (*model.ComponentResponse, error) {
 	queryParams := make(map[string]string)
 	queryParams["version"] = strconv.Itoa(componentVersion)
 
@@ -732,7 +1029,8 @@ func (c *Client) UpdateComponent(componentID, componentVersion int, req *model.C
 	pathParams["component_id"] = strconv.Itoa(componentID)
 
 	var respBody model.ComponentResponse
-	res, err := c.SendRequest(
+	res, err := c.SendRequestWithContext(
+		ctx,
 		resty.MethodPatch,
 		componentUpdateURL,
 		queryParams,
@@ -745,14 +1043,18 @@ func (c *Client) UpdateComponent(componentID, componentVersion int, req *model.C
 		return nil, err
 	}
 	if res.IsError() {
-		body, _ := io.ReadAll(res.Body)
-		return nil, fmt.Errorf("request failed with status code: %s. body: %s", res.Status(), body)
+		return nil, newAPIError(res)
 	}
 	return &respBody, nil
 }
 
 // GetComponentsPage sends a request to get components using pagination
 func (c *Client) GetComponentsPage(pageReq *model.PageRequest) ([]model.ComponentResponse, *model.PageResponse, error) {
+	return c.GetComponentsPageWithContext(context.Background(), pageReq)
+}
+
+// GetComponentsPageWithContext is like GetComponentsPage but uses ctx for cancellation and deadlines
+func (c *Client) GetComponentsPageWithContext(ctx context.Context, pageReq *model.PageRequest) ([]model.ComponentResponse, *model.PageResponse, error) {
 	if pageReq.PerPage <= 0 {
 		pageReq.PerPage = 5
 	}
@@ -764,7 +1066,8 @@ func (c *Client) GetComponentsPage(pageReq *model.PageRequest) ([]model.Componen
 	queryParams["page"] = strconv.Itoa(pageReq.Page)
 
 	var respBody []model.ComponentResponse
-	res, err := c.SendRequest(
+	res, err := c.SendRequestWithContext(
+		ctx,
 		resty.MethodGet,
 		componentsGetURL,
 		queryParams,
@@ -777,8 +1080,7 @@ func (c *Client) GetComponentsPage(pageReq *model.PageRequest) ([]model.Componen
 		return nil, nil, err
 	}
 	if res.IsError() {
-		body, _ := io.ReadAll(res.Body)
-		return nil, nil, fmt.Errorf("request failed with status code: %s. body: %s", res.Status(), body)
+		return nil, nil, newAPIError(res)
 	}
 
 	totalPages, _ := strconv.Atoi(res.Header().Get("X-Total-Pages"))
@@ -790,34 +1092,29 @@ func (c *Client) GetComponentsPage(pageReq *model.PageRequest) ([]model.Componen
 	return respBody, &pageResp, nil
 }
 
-// GetComponentsAll sends a request to find all components
-func (c *Client) GetComponentsAll() ([]model.ComponentResponse, error) {
-	currentPage := 1
-	pageReq := model.PageRequest{
-		Page:    currentPage,
-		PerPage: defaultPerPage,
-	}
+// GetComponentsAll sends a request to find all components.
+// Objects from the pages that succeeded are returned with *PartialResultError if a later page fails.
+func (c *Client) GetComponentsAll(opts ...PaginationOption) ([]model.ComponentResponse, error) {
+	return c.GetComponentsAllWithContext(context.Background(), opts...)
+}
 
-	result, pag, err := c.GetComponentsPage(&pageReq)
-	if err != nil {
-		return nil, err
-	}
-	totalPages := pag.TotalPages
-	for currentPage < totalPages {
-		currentPage++
-		pageReq.Page = currentPage
-		resp, _, _ := c.GetComponentsPage(&pageReq)
-		result = append(result, resp...)
-	}
-	return result, nil
+// GetComponentsAllWithContext is like GetComponentsAll but uses ctx for cancellation and deadlines
+func (c *Client) GetComponentsAllWithContext(ctx context.Context, opts ...PaginationOption) ([]model.ComponentResponse, error) {
+	return collectPages(ctx, c, "GetComponentsAll", c.GetComponentsPageWithContext, opts)
 }
 
 // GetComponent sends request to get information about concrete component.
 func (c *Client) GetComponent(componentID int) (*model.ComponentResponse, error) {
+	return c.GetComponentWithContext(context.Background(), componentID)
+}
+
+// GetComponentWithContext is like GetComponent but uses ctx for cancellation and deadlines
+func (c *Client) GetComponentWithContext(ctx context.Context, componentID int) (*model.ComponentResponse, error) {
 	pathParams := make(map[string]string)
 	pathParams["component_id"] = strconv.Itoa(componentID)
 	var respBody model.ComponentResponse
-	res, err := c.SendRequest(
+	res, err := c.SendRequestWithContext(
+		ctx,
 		resty.MethodGet,
 		componentGetURL,
 		nil,
@@ -830,18 +1127,23 @@ func (c *Client) GetComponent(componentID int) (*model.ComponentResponse, error)
 		return nil, err
 	}
 	if res.IsError() {
-		body, _ := io.ReadAll(res.Body)
-		return nil, fmt.Errorf("request failed with status code: %s. body: %s", res.Status(), body)
+		return nil, newAPIError(res)
 	}
 	return &respBody, nil
 }
 
 // GetIssueAttachments sends request to get attachments to issue.
 func (c *Client) GetIssueAttachments(issueID string) ([]model.AttachmentFileResponse, error) {
+	return c.GetIssueAttachmentsWithContext(context.Background(), issueID)
+}
+
+// GetIssueAttachmentsWithContext is like GetIssueAttachments but uses ctx for cancellation and deadlines
+func (c *Client) GetIssueAttachmentsWithContext(ctx context.Context, issueID string) ([]model.AttachmentFileResponse, error) {
 	pathParams := make(map[string]string)
 	pathParams["issue_id"] = issueID
 	var respBody []model.AttachmentFileResponse
-	res, err := c.SendRequest(
+	res, err := c.SendRequestWithContext(
+		ctx,
 		resty.MethodGet,
 		issueGetAttachmentsURL,
 		nil,
@@ -854,19 +1156,24 @@ func (c *Client) GetIssueAttachments(issueID string) ([]model.AttachmentFileResp
 		return nil, err
 	}
 	if res.IsError() {
-		body, _ := io.ReadAll(res.Body)
-		return nil, fmt.Errorf("request failed with status code: %s. body: %s", res.Status(), body)
+		return nil, newAPIError(res)
 	}
 	return respBody, nil
 }
 
 // GetIssueAttachment sends request to get concrete attachment to issue.
 func (c *Client) GetIssueAttachment(issueID, attachmentID string) (*model.AttachmentFileResponse, error) {
+	return c.GetIssueAttachmentWithContext(context.Background(), issueID, attachmentID)
+}
+
+// GetIssueAttachmentWithContext is like GetIssueAttachment but uses ctx for cancellation and deadlines
+func (c *Client) GetIssueAttachmentWithContext(ctx context.Context, issueID, attachmentID string) (*model.AttachmentFileResponse, error) {
 	pathParams := make(map[string]string)
 	pathParams["issue_id"] = issueID
 	pathParams["attachment_id"] = attachmentID
 	var respBody *model.AttachmentFileResponse
-	res, err := c.SendRequest(
+	res, err := c.SendRequestWithContext(
+		ctx,
 		resty.MethodGet,
 		issueGetAttachmentURL,
 		nil,
@@ -879,17 +1186,22 @@ func (c *Client) GetIssueAttachment(issueID, attachmentID string) (*model.Attach
 		return nil, err
 	}
 	if res.IsError() {
-		body, _ := io.ReadAll(res.Body)
-		return nil, fmt.Errorf("request failed with status code: %s. body: %s", res.Status(), body)
+		return nil, newAPIError(res)
 	}
 	return respBody, nil
 }
 
 // UploadTemporaryAttachment sends request to upload temporary attachment.
 func (c *Client) UploadTemporaryAttachment(multipartReq *resty.MultipartField) (*model.AttachmentFileResponse, error) {
+	return c.UploadTemporaryAttachmentWithContext(context.Background(), multipartReq)
+}
+
+// UploadTemporaryAttachmentWithContext is like UploadTemporaryAttachment but uses ctx for cancellation and deadlines
+func (c *Client) UploadTemporaryAttachmentWithContext(ctx context.Context, multipartReq *resty.MultipartField) (*model.AttachmentFileResponse, error) {
 	var respBody *model.AttachmentFileResponse
 	multipartReq.Name = "filename"
-	res, err := c.SendMultipartRequest(
+	res, err := c.SendMultipartRequestWithContext(
+		ctx,
 		resty.MethodPost,
 		attachmentUploadURL,
 		nil,
@@ -902,19 +1214,24 @@ func (c *Client) UploadTemporaryAttachment(multipartReq *resty.MultipartField) (
 		return nil, err
 	}
 	if res.IsError() {
-		body, _ := io.ReadAll(res.Body)
-		return nil, fmt.Errorf("request failed with status code: %s. body: %s", res.Status(), body)
+		return nil, newAPIError(res)
 	}
 	return respBody, nil
 }
 
 // IssueAttachFile sends request to upload an attachment to attach to issue.
 func (c *Client) IssueAttachFile(issueID string, multipartReq *resty.MultipartField) (*model.AttachmentFileResponse, error) {
+	return c.IssueAttachFileWithContext(context.Background(), issueID, multipartReq)
+}
+
+// IssueAttachFileWithContext is like IssueAttachFile but uses ctx for cancellation and deadlines
+func (c *Client) IssueAttachFileWithContext(ctx context.Context, issueID string, multipartReq *resty.MultipartField) (*model.AttachmentFileResponse, error) {
 	var respBody *model.AttachmentFileResponse
 	pathParams := make(map[string]string)
 	pathParams["issue_id"] = issueID
 	multipartReq.Name = "filename"
-	res, err := c.SendMultipartRequest(
+	res, err := c.SendMultipartRequestWithContext(
+		ctx,
 		resty.MethodPost,
 		issueAttachFileURL,
 		nil,
@@ -927,18 +1244,23 @@ func (c *Client) IssueAttachFile(issueID string, multipartReq *resty.MultipartFi
 		return nil, err
 	}
 	if res.IsError() {
-		body, _ := io.ReadAll(res.Body)
-		return nil, fmt.Errorf("request failed with status code: %s. body: %s", res.Status(), body)
+		return nil, newAPIError(res)
 	}
 	return respBody, nil
 }
 
 // IssueDeleteFile sends request to delete an attachment in issue.
 func (c *Client) IssueDeleteFile(issueID, fileID string) error {
+	return c.IssueDeleteFileWithContext(context.Background(), issueID, fileID)
+}
+
+// IssueDeleteFileWithContext is like IssueDeleteFile but uses ctx for cancellation and deadlines
+func (c *Client) IssueDeleteFileWithContext(ctx context.Context, issueID, fileID string) error {
 	pathParams := make(map[string]string)
 	pathParams["issue_id"] = issueID
 	pathParams["file_id"] = fileID
-	res, err := c.SendRequest(
+	res, err := c.SendRequestWithContext(
+		ctx,
 		resty.MethodDelete,
 		issueDeleteFileURL,
 		nil,
@@ -951,8 +1273,562 @@ func (c *Client) IssueDeleteFile(issueID, fileID string) error {
 		return err
 	}
 	if res.IsError() {
-		body, _ := io.ReadAll(res.Body)
-		return fmt.Errorf("request failed with status code: %s. body: %s", res.Status(), body)
+		return newAPIError(res)
+	}
+	return nil
+}
+
+// CreateIssueLink sends request to link the issue with another one.
+func (c *Client) CreateIssueLink(issueID string, req *model.IssueLinkRequest) (*model.IssueLinkResponse, error) {
+	return c.CreateIssueLinkWithContext(context.Background(), issueID, req)
+}
+
+// CreateIssueLinkWithContext is like CreateIssueLink but uses ctx for cancellation and deadlines
+func (c *Client) CreateIssueLinkWithContext(ctx context.Context, issueID string, req *model.IssueLinkRequest) (*model.IssueLinkResponse, error) {
+	pathParams := make(map[string]string)
+	pathParams["issue_id"] = issueID
+	var respBody model.IssueLinkResponse
+	res, err := c.SendRequestWithContext(
+		ctx,
+		resty.MethodPost,
+		issueCreateLinkURL,
+		nil,
+		nil,
+		pathParams,
+		req,
+		&respBody,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if res.IsError() {
+		return nil, newAPIError(res)
+	}
+	return &respBody, nil
+}
+
+// GetIssueLinks sends request to get links of the issue.
+func (c *Client) GetIssueLinks(issueID string) ([]model.IssueLinkResponse, error) {
+	return c.GetIssueLinksWithContext(context.Background(), issueID)
+}
+
+// GetIssueLinksWithContext is like GetIssueLinks but uses ctx for cancellation and deadlines
+func (c *Client) GetIssueLinksWithContext(ctx context.Context, issueID string) ([]model.IssueLinkResponse, error) {
+	pathParams := make(map[string]string)
+	pathParams["issue_id"] = issueID
+	var respBody []model.IssueLinkResponse
+	res, err := c.SendRequestWithContext(
+		ctx,
+		resty.MethodGet,
+		issueGetLinksURL,
+		nil,
+		nil,
+		pathParams,
+		nil,
+		&respBody,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if res.IsError() {
+		return nil, newAPIError(res)
+	}
+	return respBody, nil
+}
+
+// DeleteIssueLink sends request to delete a link of the issue.
+func (c *Client) DeleteIssueLink(issueID string, linkID int) error {
+	return c.DeleteIssueLinkWithContext(context.Background(), issueID, linkID)
+}
+
+// DeleteIssueLinkWithContext is like DeleteIssueLink but uses ctx for cancellation and deadlines
+func (c *Client) DeleteIssueLinkWithContext(ctx context.Context, issueID string, linkID int) error {
+	pathParams := make(map[string]string)
+	pathParams["issue_id"] = issueID
+	pathParams["link_id"] = strconv.Itoa(linkID)
+	res, err := c.SendRequestWithContext(
+		ctx,
+		resty.MethodDelete,
+		issueDeleteLinkURL,
+		nil,
+		nil,
+		pathParams,
+		nil,
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+	if res.IsError() {
+		return newAPIError(res)
+	}
+	return nil
+}
+
+// CreateWorklog sends request to add a record of time spent on the issue.
+func (c *Client) CreateWorklog(issueID string, req *model.WorklogRequest) (*model.WorklogResponse, error) {
+	return c.CreateWorklogWithContext(context.Background(), issueID, req)
+}
+
+// CreateWorklogWithContext is like CreateWorklog but uses ctx for cancellation and deadlines
+func (c *Client) CreateWorklogWithContext(ctx context.Context, issueID string, req *model.WorklogRequest) (*model.WorklogResponse, error) {
+	pathParams := make(map[string]string)
+	pathParams["issue_id"] = issueID
+	var respBody model.WorklogResponse
+	res, err := c.SendRequestWithContext(
+		ctx,
+		resty.MethodPost,
+		issueCreateWorklogURL,
+		nil,
+		nil,
+		pathParams,
+		req,
+		&respBody,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if res.IsError() {
+		return nil, newAPIError(res)
+	}
+	return &respBody, nil
+}
+
+// GetIssueWorklogs sends request to get records of time spent on the issue.
+func (c *Client) GetIssueWorklogs(issueID string) ([]model.WorklogResponse, error) {
+	return c.GetIssueWorklogsWithContext(context.Background(), issueID)
+}
+
+// GetIssueWorklogsWithContext is like GetIssueWorklogs but uses ctx for cancellation and deadlines
+func (c *Client) GetIssueWorklogsWithContext(ctx context.Context, issueID string) ([]model.WorklogResponse, error) {
+	pathParams := make(map[string]string)
+	pathParams["issue_id"] = issueID
+	var respBody []model.WorklogResponse
+	res, err := c.SendRequestWithContext(
+		ctx,
+		resty.MethodGet,
+		issueGetWorklogsURL,
+		nil,
+		nil,
+		pathParams,
+		nil,
+		&respBody,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if res.IsError() {
+		return nil, newAPIError(res)
+	}
+	return respBody, nil
+}
+
+// UpdateWorklog sends request to update the record of time spent on the issue.
+func (c *Client) UpdateWorklog(issueID string, worklogID int, req *model.WorklogUpdateRequest) (*model.WorklogResponse, error) {
+	return c.UpdateWorklogWithContext(context.Background(), issueID, worklogID, req)
+}
+
+// UpdateWorklogWithContext is like UpdateWorklog but uses ctx for cancellation and deadlines
+func (c *Client) UpdateWorklogWithContext(ctx context.Context, issueID string, worklogID int, req *model.WorklogUpdateRequest) (*model.WorklogResponse, error) {
+	pathParams := make(map[string]string)
+	pathParams["issue_id"] = issueID
+	pathParams["worklog_id"] = strconv.Itoa(worklogID)
+	var respBody model.WorklogResponse
+	res, err := c.SendRequestWithContext(
+		ctx,
+		resty.MethodPatch,
+		issueUpdateWorklogURL,
+		nil,
+		nil,
+		pathParams,
+		req,
+		&respBody,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if res.IsError() {
+		return nil, newAPIError(res)
+	}
+	return &respBody, nil
+}
+
+// DeleteWorklog sends request to delete the record of time spent on the issue.
+func (c *Client) DeleteWorklog(issueID string, worklogID int) error {
+	return c.DeleteWorklogWithContext(context.Background(), issueID, worklogID)
+}
+
+// DeleteWorklogWithContext is like DeleteWorklog but uses ctx for cancellation and deadlines
+func (c *Client) DeleteWorklogWithContext(ctx context.Context, issueID string, worklogID int) error {
+	pathParams := make(map[string]string)
+	pathParams["issue_id"] = issueID
+	pathParams["worklog_id"] = strconv.Itoa(worklogID)
+	res, err := c.SendRequestWithContext(
+		ctx,
+		resty.MethodDelete,
+		issueDeleteWorklogURL,
+		nil,
+		nil,
+		pathParams,
+		nil,
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+	if res.IsError() {
+		return newAPIError(res)
 	}
 	return nil
 }
+
+// SearchWorklogsPage sends a request to find records of spent time by author and creation date using pagination
+func (c *Client) SearchWorklogsPage(req *model.WorklogSearchRequest, pageReq *model.PageRequest) ([]model.WorklogResponse, *model.PageResponse, error) {
+	return c.SearchWorklogsPageWithContext(context.Background(), req, pageReq)
+}
+
+// SearchWorklogsPageWithContext is like SearchWorklogsPage but uses ctx for cancellation and deadlines
+func (c *Client) SearchWorklogsPageWithContext(ctx context.Context, req *model.WorklogSearchRequest, pageReq *model.PageRequest) ([]model.WorklogResponse, *model.PageResponse, error) {
+	if pageReq.PerPage <= 0 {
+		pageReq.PerPage = defaultPerPage
+	}
+	if pageReq.Page <= 0 {
+		pageReq.Page = 1
+	}
+	queryParams := make(map[string]string)
+	queryParams["perPage"] = strconv.Itoa(pageReq.PerPage)
+	queryParams["page"] = strconv.Itoa(pageReq.Page)
+
+	var respBody []model.WorklogResponse
+	res, err := c.SendRequestWithContext(
+		ctx,
+		resty.MethodPost,
+		worklogSearchURL,
+		queryParams,
+		nil,
+		nil,
+		req,
+		&respBody,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	if res.IsError() {
+		return nil, nil, newAPIError(res)
+	}
+
+	totalPages, _ := strconv.Atoi(res.Header().Get("X-Total-Pages"))
+	totalCount, _ := strconv.Atoi(res.Header().Get("X-Total-Count"))
+	pageResp := model.PageResponse{
+		TotalPages: totalPages,
+		TotalCount: totalCount,
+	}
+	return respBody, &pageResp, nil
+}
+
+// SearchAllWorklogs sends a request to find all records of spent time by author and creation date.
+// Objects from the pages that succeeded are returned with *PartialResultError if a later page fails.
+func (c *Client) SearchAllWorklogs(req *model.WorklogSearchRequest, opts ...PaginationOption) ([]model.WorklogResponse, error) {
+	return c.SearchAllWorklogsWithContext(context.Background(), req, opts...)
+}
+
+// SearchAllWorklogsWithContext is like SearchAllWorklogs but uses ctx for cancellation and deadlines
+func (c *Client) SearchAllWorklogsWithContext(ctx context.Context, req *model.WorklogSearchRequest, opts ...PaginationOption) ([]model.WorklogResponse, error) {
+	return collectPages(ctx, c, "SearchAllWorklogs", func(ctx context.Context, pageReq *model.PageRequest) ([]model.WorklogResponse, *model.PageResponse, error) {
+		return c.SearchWorklogsPageWithContext(ctx, req, pageReq)
+	}, opts)
+}
+
+// GetChecklist sends request to get checklist items of the issue.
+func (c *Client) GetChecklist(issueID string) ([]model.ChecklistItemResponse, error) {
+	return c.GetChecklistWithContext(context.Background(), issueID)
+}
+
+// GetChecklistWithContext is like GetChecklist but uses ctx for cancellation and deadlines
+func (c *Client) GetChecklistWithContext(ctx context.Context, issueID string) ([]model.ChecklistItemResponse, error) {
+	pathParams := make(map[string]string)
+	pathParams["issue_id"] = issueID
+	var respBody []model.ChecklistItemResponse
+	res, err := c.SendRequestWithContext(
+		ctx,
+		resty.MethodGet,
+		issueGetChecklistURL,
+		nil,
+		nil,
+		pathParams,
+		nil,
+		&respBody,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if res.IsError() {
+		return nil, newAPIError(res)
+	}
+	return respBody, nil
+}
+
+// AddChecklistItem sends request to add an item to the checklist of the issue.
+// The whole checklist is returned.
+func (c *Client) AddChecklistItem(issueID string, req *model.ChecklistItemRequest) ([]model.ChecklistItemResponse, error) {
+	return c.AddChecklistItemWithContext(context.Background(), issueID, req)
+}
+
+// AddChecklistItemWithContext is like AddChecklistItem but uses ctx for cancellation and deadlines
+func (c *Client) AddChecklistItemWithContext(ctx context.Context, issueID string, req *model.ChecklistItemRequest) ([]model.ChecklistItemResponse, error) {
+	pathParams := make(map[string]string)
+	pathParams["issue_id"] = issueID
+	var respBody model.IssueResponse
+	res, err := c.SendRequestWithContext(
+		ctx,
+		resty.MethodPost,
+		issueAddChecklistItemURL,
+		nil,
+		nil,
+		pathParams,
+		req,
+		&respBody,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if res.IsError() {
+		return nil, newAPIError(res)
+	}
+	return respBody.ChecklistItems, nil
+}
+
+// EditChecklistItem sends request to edit text, state, assignee or deadline of the checklist item.
+// The whole checklist is returned.
+func (c *Client) EditChecklistItem(issueID, itemID string, req *model.ChecklistItemRequest) ([]model.ChecklistItemResponse, error) {
+	return c.EditChecklistItemWithContext(context.Background(), issueID, itemID, req)
+}
+
+// EditChecklistItemWithContext is like EditChecklistItem but uses ctx for cancellation and deadlines
+func (c *Client) EditChecklistItemWithContext(ctx context.Context, issueID, itemID string, req *model.ChecklistItemRequest) ([]model.ChecklistItemResponse, error) {
+	pathParams := make(map[string]string)
+	pathParams["issue_id"] = issueID
+	pathParams["item_id"] = itemID
+	var respBody model.IssueResponse
+	res, err := c.SendRequestWithContext(
+		ctx,
+		resty.MethodPatch,
+		issueEditChecklistItemURL,
+		nil,
+		nil,
+		pathParams,
+		req,
+		&respBody,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if res.IsError() {
+		return nil, newAPIError(res)
+	}
+	return respBody.ChecklistItems, nil
+}
+
+// MoveChecklistItem sends request to place the checklist item before the one with beforeID.
+// The whole checklist is returned.
+func (c *Client) MoveChecklistItem(issueID, itemID, beforeID string) ([]model.ChecklistItemResponse, error) {
+	return c.MoveChecklistItemWithContext(context.Background(), issueID, itemID, beforeID)
+}
+
+// MoveChecklistItemWithContext is like MoveChecklistItem but uses ctx for cancellation and deadlines
+func (c *Client) MoveChecklistItemWithContext(ctx context.Context, issueID, itemID, beforeID string) ([]model.ChecklistItemResponse, error) {
+	pathParams := make(map[string]string)
+	pathParams["issue_id"] = issueID
+	pathParams["item_id"] = itemID
+	var respBody model.IssueResponse
+	res, err := c.SendRequestWithContext(
+		ctx,
+		resty.MethodPost,
+		issueMoveChecklistItemURL,
+		nil,
+		nil,
+		pathParams,
+		&model.ChecklistItemMoveRequest{Before: beforeID},
+		&respBody,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if res.IsError() {
+		return nil, newAPIError(res)
+	}
+	return respBody.ChecklistItems, nil
+}
+
+// DeleteChecklistItem sends request to delete the checklist item.
+// The remaining checklist is returned.
+func (c *Client) DeleteChecklistItem(issueID, itemID string) ([]model.ChecklistItemResponse, error) {
+	return c.DeleteChecklistItemWithContext(context.Background(), issueID, itemID)
+}
+
+// DeleteChecklistItemWithContext is like DeleteChecklistItem but uses ctx for cancellation and deadlines
+func (c *Client) DeleteChecklistItemWithContext(ctx context.Context, issueID, itemID string) ([]model.ChecklistItemResponse, error) {
+	pathParams := make(map[string]string)
+	pathParams["issue_id"] = issueID
+	pathParams["item_id"] = itemID
+	var respBody model.IssueResponse
+	res, err := c.SendRequestWithContext(
+		ctx,
+		resty.MethodDelete,
+		issueDeleteChecklistItemURL,
+		nil,
+		nil,
+		pathParams,
+		nil,
+		&respBody,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if res.IsError() {
+		return nil, newAPIError(res)
+	}
+	return respBody.ChecklistItems, nil
+}
+
+// MarkChecklistItemsDone checks the checklist items whose text matches text ignoring case and surrounding spaces.
+// Items that are already checked are left as is. The whole checklist is returned.
+// An error matching ErrNotFound is returned if no item matches.
+func (c *Client) MarkChecklistItemsDone(issueID, text string) ([]model.ChecklistItemResponse, error) {
+	return c.MarkChecklistItemsDoneWithContext(context.Background(), issueID, text)
+}
+
+// MarkChecklistItemsDoneWithContext is like MarkChecklistItemsDone but uses ctx for cancellation and deadlines
+func (c *Client) MarkChecklistItemsDoneWithContext(ctx context.Context, issueID, text string) ([]model.ChecklistItemResponse, error) {
+	items, err := c.GetChecklistWithContext(ctx, issueID)
+	if err != nil {
+		return nil, err
+	}
+	matched := false
+	checked := true
+	for _, item := range items {
+		if !strings.EqualFold(strings.TrimSpace(item.Text), strings.TrimSpace(text)) {
+			continue
+		}
+		matched = true
+		if item.Checked {
+			continue
+		}
+		items, err = c.EditChecklistItemWithContext(ctx, issueID, item.ID, &model.ChecklistItemRequest{Checked: &checked})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if !matched {
+		return nil, fmt.Errorf("checklist item %q of issue %s: %w", text, issueID, ErrNotFound)
+	}
+	return items, nil
+}
+
+// GetIssueChangelog sends request to get first req.PerPage entries of the issue changelog after the one with ID req.FromID.
+// Pass ID of the last returned entry as FromID to get the next page. Nil req gets the first page of the default size.
+func (c *Client) GetIssueChangelog(issueID string, req *model.ChangelogRequest) ([]model.ChangelogResponse, error) {
+	return c.GetIssueChangelogWithContext(context.Background(), issueID, req)
+}
+
+// GetIssueChangelogWithContext is like GetIssueChangelog but uses ctx for cancellation and deadlines
+func (c *Client) GetIssueChangelogWithContext(ctx context.Context, issueID string, req *model.ChangelogRequest) ([]model.ChangelogResponse, error) {
+	if req == nil {
+		req = &model.ChangelogRequest{}
+	}
+	queryParams := make(map[string]string)
+	queryParams["perPage"] = strconv.Itoa(perPageOrDefault(req.PerPage))
+	if req.FromID != "" {
+		queryParams["id"] = req.FromID
+	}
+	multiplyQueryParams := url.Values{}
+	for _, field := range req.Fields {
+		multiplyQueryParams.Add("field", field)
+	}
+	for _, changeType := range req.Types {
+		multiplyQueryParams.Add("type", changeType)
+	}
+
+	pathParams := make(map[string]string)
+	pathParams["issue_id"] = issueID
+	var respBody []model.ChangelogResponse
+	res, err := c.SendRequestWithContext(
+		ctx,
+		resty.MethodGet,
+		issueGetChangelogURL,
+		queryParams,
+		multiplyQueryParams,
+		pathParams,
+		nil,
+		&respBody,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if res.IsError() {
+		return nil, newAPIError(res)
+	}
+	return respBody, nil
+}
+
+// MoveIssue sends request to move the issue to another queue. The returned issue has the new key,
+// the old one keeps redirecting to it.
+func (c *Client) MoveIssue(issueID string, req *model.IssueMoveRequest) (*model.IssueResponse, error) {
+	return c.MoveIssueWithContext(context.Background(), issueID, req)
+}
+
+// MoveIssueWithContext is like MoveIssue but uses ctx for cancellation and deadlines
+func (c *Client) MoveIssueWithContext(ctx context.Context, issueID string, req *model.IssueMoveRequest) (*model.IssueResponse, error) {
+	if req == nil || req.Queue == "" {
+		return nil, errors.New("empty target queue")
+	}
+	queryParams := make(map[string]string)
+	queryParams["queue"] = req.Queue
+	if req.MoveAllFields {
+		queryParams["moveAllFields"] = "true"
+	}
+	if req.InitialStatus {
+		queryParams["initialStatus"] = "true"
+	}
+
+	pathParams := make(map[string]string)
+	pathParams["issue_id"] = issueID
+	var respBody model.IssueResponse
+	res, err := c.SendRequestWithContext(
+		ctx,
+		resty.MethodPost,
+		issueMoveURL,
+		queryParams,
+		nil,
+		pathParams,
+		req,
+		&respBody,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if res.IsError() {
+		return nil, newAPIError(res)
+	}
+	return &respBody, nil
+}
+
+// MoveIssues sends requests to move the issues to another queue one by one, so new keys follow the order of issueIDs.
+// The returned map contains new keys by the passed keys or IDs of the moved issues.
+// Moving stops at the first failure; the issues moved before it are still returned.
+func (c *Client) MoveIssues(issueIDs []string, req *model.IssueMoveRequest) (map[string]string, error) {
+	return c.MoveIssuesWithContext(context.Background(), issueIDs, req)
+}
+
+// MoveIssuesWithContext is like MoveIssues but uses ctx for cancellation and deadlines
+func (c *Client) MoveIssuesWithContext(ctx context.Context, issueIDs []string, req *model.IssueMoveRequest) (map[string]string, error) {
+	newKeys := make(map[string]string, len(issueIDs))
+	for _, issueID := range issueIDs {
+		moved, err := c.MoveIssueWithContext(ctx, issueID, req)
+		if err != nil {
+			return newKeys, fmt.Errorf("failed to move issue %s: %w", issueID, err)
+		}
+		newKeys[issueID] = moved.Key
+	}
+	return newKeys, nil
+}
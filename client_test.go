@@ -0,0 +1,379 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	client "github.com/IndianMax03/yandex-tracker-go-client"
+	"github.com/IndianMax03/yandex-tracker-go-client/model"
+	"github.com/IndianMax03/yandex-tracker-go-client/trackertest"
+	"resty.dev/v3"
+)
+
+func TestIssueLifecycle(t *testing.T) {
+	_, c := newTestClient(t)
+
+	created, err := c.CreateIssue(&model.IssueCreateRequest{Summary: "First", Queue: model.Queue{Key: "TEST"}})
+	if err != nil {
+		t.Fatalf("CreateIssue failed: %v", err)
+	}
+	if created.Key != "TEST-1" {
+		t.Errorf("got key %q, want TEST-1", created.Key)
+	}
+
+	modified, err := c.ModifyIssue(created.Key, &model.IssueModifyRequest{Summary: "Second", Tags: []string{"backend"}})
+	if err != nil {
+		t.Fatalf("ModifyIssue failed: %v", err)
+	}
+	if modified.Summary != "Second" || !slices.Equal(modified.Tags, []string{"backend"}) {
+		t.Errorf("got summary %q and tags %v after modification", modified.Summary, modified.Tags)
+	}
+
+	got, err := c.GetIssue(created.Key, false, false)
+	if err != nil {
+		t.Fatalf("GetIssue failed: %v", err)
+	}
+	if got.ID != created.ID || got.Summary != "Second" {
+		t.Errorf("got issue %q with summary %q", got.ID, got.Summary)
+	}
+
+	changelog, err := c.GetIssueChangelog(created.Key, &model.ChangelogRequest{Fields: []string{model.ChangelogFieldTags}})
+	if err != nil {
+		t.Fatalf("GetIssueChangelog failed: %v", err)
+	}
+	if len(changelog) != 1 || changelog[0].Type != model.ChangelogTypeIssueUpdated {
+		t.Fatalf("got changelog %+v, want one update of tags", changelog)
+	}
+	var tags *model.TagsDiff
+	for _, field := range changelog[0].Fields {
+		if field.Tags != nil {
+			tags = field.Tags
+		}
+	}
+	if tags == nil || !slices.Equal(tags.Added, []string{"backend"}) {
+		t.Errorf("got tags diff %+v, want backend added", tags)
+	}
+
+	_, err = c.GetIssue("TEST-404", false, false)
+	if !errors.Is(err, client.ErrNotFound) {
+		t.Errorf("got error %v for missing issue, want ErrNotFound", err)
+	}
+}
+
+func TestGetIssueChangelogDefaults(t *testing.T) {
+	srv, c := newTestClient(t)
+	created, err := c.CreateIssue(&model.IssueCreateRequest{Summary: "First", Queue: model.Queue{Key: "TEST"}})
+	if err != nil {
+		t.Fatalf("CreateIssue failed: %v", err)
+	}
+	if _, err := c.ModifyIssue(created.Key, &model.IssueModifyRequest{Tags: []string{"backend"}}); err != nil {
+		t.Fatalf("ModifyIssue failed: %v", err)
+	}
+
+	changelog, err := c.GetIssueChangelog(created.Key, nil)
+	if err != nil {
+		t.Fatalf("GetIssueChangelog failed with nil request: %v", err)
+	}
+	if len(changelog) == 0 {
+		t.Fatal("got empty changelog, want the update of tags")
+	}
+	requests := srv.Requests()
+	if query := requests[len(requests)-1].Query; query != "perPage=50" {
+		t.Errorf("got query %q, want the default page size", query)
+	}
+
+	req := &model.ChangelogRequest{}
+	if _, err := c.GetIssueChangelog(created.Key, req); err != nil {
+		t.Fatalf("GetIssueChangelog failed: %v", err)
+	}
+	if req.PerPage != 0 {
+		t.Errorf("got PerPage %d written into the request, want it unchanged", req.PerPage)
+	}
+
+	var entries []model.ChangelogResponse
+	for entry, err := range c.GetIssueChangelogSeq(context.Background(), created.Key, nil) {
+		if err != nil {
+			t.Fatalf("GetIssueChangelogSeq failed with nil request: %v", err)
+		}
+		entries = append(entries, entry)
+	}
+	if len(entries) != len(changelog) {
+		t.Errorf("got %d entries from the iterator, want %d", len(entries), len(changelog))
+	}
+}
+
+func TestSearchIssues(t *testing.T) {
+	srv, c := newTestClient(t)
+	keys := addIssues(t, srv, "TEST", 5)
+	addIssues(t, srv, "OTHER", 2)
+
+	issues, err := c.SearchAllIssues(&model.IssueSearchRequest{Queue: "TEST"}, client.FetchPagesConcurrently(2))
+	if err != nil {
+		t.Fatalf("SearchAllIssues failed: %v", err)
+	}
+	found := make([]string, 0, len(issues))
+	for _, issue := range issues {
+		found = append(found, issue.Key)
+	}
+	if !slices.Equal(found, keys) {
+		t.Errorf("got keys %v, want %v", found, keys)
+	}
+
+	count, err := c.GetIssuesCount(&model.IssueCountRequest{Filter: map[string]any{"queue": "OTHER"}})
+	if err != nil {
+		t.Fatalf("GetIssuesCount failed: %v", err)
+	}
+	if count != 2 {
+		t.Errorf("got count %d, want 2", count)
+	}
+
+	var streamed []string
+	_, err = c.SearchIssuesStream(&model.IssueSearchRequest{Queue: "TEST"}, &model.PageRequest{PerPage: 10}, func(issue *model.IssueResponse) error {
+		streamed = append(streamed, issue.Key)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("SearchIssuesStream failed: %v", err)
+	}
+	if !slices.Equal(streamed, keys) {
+		t.Errorf("got streamed keys %v, want %v", streamed, keys)
+	}
+}
+
+func TestComments(t *testing.T) {
+	srv, c := newTestClient(t)
+	key := addIssues(t, srv, "TEST", 1)[0]
+
+	created, err := c.CreateComment(key, &model.CommentRequest{Text: "first"})
+	if err != nil {
+		t.Fatalf("CreateComment failed: %v", err)
+	}
+	updated, err := c.UpdateComment(key, created.ID, &model.CommentUpdateRequest{Text: "edited"})
+	if err != nil {
+		t.Fatalf("UpdateComment failed: %v", err)
+	}
+	if updated.Text != "edited" {
+		t.Errorf("got text %q, want edited", updated.Text)
+	}
+	if _, err := c.CreateComment(key, &model.CommentRequest{Text: "second"}); err != nil {
+		t.Fatalf("CreateComment failed: %v", err)
+	}
+
+	comments, err := c.GetCommentsAll(key, "")
+	if err != nil {
+		t.Fatalf("GetCommentsAll failed: %v", err)
+	}
+	if len(comments) != 2 || comments[0].Text != "edited" || comments[1].Text != "second" {
+		t.Errorf("got comments %+v", comments)
+	}
+
+	if err := c.DeleteComment(key, created.ID); err != nil {
+		t.Fatalf("DeleteComment failed: %v", err)
+	}
+	if _, err := c.GetComment(key, created.ID); !errors.Is(err, client.ErrNotFound) {
+		t.Errorf("got error %v for deleted comment, want ErrNotFound", err)
+	}
+	if got := len(srv.Comments(key)); got != 1 {
+		t.Errorf("got %d comments stored, want 1", got)
+	}
+}
+
+func TestAttachments(t *testing.T) {
+	srv, c := newTestClient(t)
+	key := addIssues(t, srv, "TEST", 1)[0]
+
+	attached, err := c.IssueAttachFile(key, &resty.MultipartField{FileName: "notes.txt", Reader: strings.NewReader("hello")})
+	if err != nil {
+		t.Fatalf("IssueAttachFile failed: %v", err)
+	}
+	if attached.Name != "notes.txt" {
+		t.Errorf("got name %q, want notes.txt", attached.Name)
+	}
+	content, ok := srv.AttachmentContent(key, attached.ID)
+	if !ok || string(content) != "hello" {
+		t.Errorf("got stored content %q", content)
+	}
+
+	attachments, err := c.GetIssueAttachments(key)
+	if err != nil {
+		t.Fatalf("GetIssueAttachments failed: %v", err)
+	}
+	if len(attachments) != 1 || attachments[0].ID != attached.ID {
+		t.Errorf("got attachments %+v", attachments)
+	}
+
+	if err := c.IssueDeleteFile(key, attached.ID); err != nil {
+		t.Fatalf("IssueDeleteFile failed: %v", err)
+	}
+	if _, err := c.GetIssueAttachment(key, attached.ID); !errors.Is(err, client.ErrNotFound) {
+		t.Errorf("got error %v for deleted attachment, want ErrNotFound", err)
+	}
+}
+
+func TestLinks(t *testing.T) {
+	srv, c := newTestClient(t)
+	keys := addIssues(t, srv, "TEST", 2)
+
+	link, err := c.CreateIssueLink(keys[0], &model.IssueLinkRequest{Relationship: model.RelationshipDependsOn, Issue: keys[1]})
+	if err != nil {
+		t.Fatalf("CreateIssueLink failed: %v", err)
+	}
+	if link.Object.Key != keys[1] {
+		t.Errorf("got linked issue %q, want %q", link.Object.Key, keys[1])
+	}
+
+	links, err := c.GetIssueLinks(keys[1])
+	if err != nil {
+		t.Fatalf("GetIssueLinks failed: %v", err)
+	}
+	if len(links) != 1 || links[0].Object.Key != keys[0] {
+		t.Errorf("got links of the linked issue %+v", links)
+	}
+
+	if err := c.DeleteIssueLink(keys[0], link.ID); err != nil {
+		t.Fatalf("DeleteIssueLink failed: %v", err)
+	}
+	if got := len(srv.Links(keys[1])); got != 0 {
+		t.Errorf("got %d links after deletion, want 0", got)
+	}
+}
+
+func TestWorklogs(t *testing.T) {
+	srv, c := newTestClient(t)
+	key := addIssues(t, srv, "TEST", 1)[0]
+	start := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	created, err := c.CreateWorklog(key, &model.WorklogRequest{Start: start, Duration: 90 * time.Minute, Comment: "review"})
+	if err != nil {
+		t.Fatalf("CreateWorklog failed: %v", err)
+	}
+	if created.Duration != 90*time.Minute || !created.Start.Equal(start) {
+		t.Errorf("got duration %v and start %v", created.Duration, created.Start)
+	}
+
+	updated, err := c.UpdateWorklog(key, created.ID, &model.WorklogUpdateRequest{Duration: 2 * time.Hour})
+	if err != nil {
+		t.Fatalf("UpdateWorklog failed: %v", err)
+	}
+	if updated.Duration != 2*time.Hour || updated.Comment != "review" {
+		t.Errorf("got duration %v and comment %q after update", updated.Duration, updated.Comment)
+	}
+
+	found, err := c.SearchAllWorklogs(&model.WorklogSearchRequest{CreatedBy: srv.Myself().Login})
+	if err != nil {
+		t.Fatalf("SearchAllWorklogs failed: %v", err)
+	}
+	if len(found) != 1 || found[0].ID != created.ID {
+		t.Errorf("got worklogs %+v", found)
+	}
+
+	if err := c.DeleteWorklog(key, created.ID); err != nil {
+		t.Fatalf("DeleteWorklog failed: %v", err)
+	}
+	if got := len(srv.Worklogs(key)); got != 0 {
+		t.Errorf("got %d worklogs after deletion, want 0", got)
+	}
+}
+
+func TestChecklist(t *testing.T) {
+	srv, c := newTestClient(t)
+	key := addIssues(t, srv, "TEST", 1)[0]
+
+	if _, err := c.AddChecklistItem(key, &model.ChecklistItemRequest{Text: "write"}); err != nil {
+		t.Fatalf("AddChecklistItem failed: %v", err)
+	}
+	items, err := c.AddChecklistItem(key, &model.ChecklistItemRequest{Text: "test"})
+	if err != nil {
+		t.Fatalf("AddChecklistItem failed: %v", err)
+	}
+
+	checked := true
+	items, err = c.EditChecklistItem(key, items[0].ID, &model.ChecklistItemRequest{Checked: &checked})
+	if err != nil {
+		t.Fatalf("EditChecklistItem failed: %v", err)
+	}
+	if !items[0].Checked || items[1].Checked {
+		t.Errorf("got items %+v, want only the first checked", items)
+	}
+
+	items, err = c.MoveChecklistItem(key, items[1].ID, items[0].ID)
+	if err != nil {
+		t.Fatalf("MoveChecklistItem failed: %v", err)
+	}
+	if items[0].Text != "test" || items[1].Text != "write" {
+		t.Errorf("got items %+v after move", items)
+	}
+
+	items, err = c.DeleteChecklistItem(key, items[0].ID)
+	if err != nil {
+		t.Fatalf("DeleteChecklistItem failed: %v", err)
+	}
+	if len(items) != 1 || items[0].Text != "write" {
+		t.Errorf("got items %+v after deletion", items)
+	}
+}
+
+func TestMoveIssue(t *testing.T) {
+	srv, c := newTestClient(t)
+	key := addIssues(t, srv, "TEST", 1)[0]
+
+	moved, err := c.MoveIssue(key, &model.IssueMoveRequest{Queue: "OTHER"})
+	if err != nil {
+		t.Fatalf("MoveIssue failed: %v", err)
+	}
+	if moved.Key != "OTHER-1" || moved.Queue.Key != "OTHER" {
+		t.Errorf("got key %q in queue %q", moved.Key, moved.Queue.Key)
+	}
+	got, err := c.GetIssue(key, false, false)
+	if err != nil {
+		t.Fatalf("GetIssue by the old key failed: %v", err)
+	}
+	if got.Key != moved.Key {
+		t.Errorf("got key %q by the old key, want %q", got.Key, moved.Key)
+	}
+}
+
+func TestInjectedFaults(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		want       error
+	}{
+		{"unauthorized", http.StatusUnauthorized, client.ErrUnauthorized},
+		{"forbidden", http.StatusForbidden, client.ErrForbidden},
+		{"not found", http.StatusNotFound, client.ErrNotFound},
+		{"conflict", http.StatusConflict, client.ErrConflict},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, c := newTestClient(t)
+			srv.InjectFault(trackertest.Fault{Method: http.MethodGet, PathPrefix: "/myself", StatusCode: tt.statusCode, Times: 1})
+
+			_, err := c.GetMyself()
+			var apiErr *client.APIError
+			if !errors.Is(err, tt.want) || !errors.As(err, &apiErr) || apiErr.StatusCode != tt.statusCode {
+				t.Fatalf("got error %v, want %v", err, tt.want)
+			}
+			if _, err := c.GetMyself(); err != nil {
+				t.Errorf("request after the fault failed: %v", err)
+			}
+		})
+	}
+}
+
+func TestInjectedLatency(t *testing.T) {
+	srv, c := newTestClient(t)
+	srv.InjectFault(trackertest.Fault{Latency: time.Second})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err := c.GetMyselfWithContext(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got error %v, want context.DeadlineExceeded", err)
+	}
+}
@@ -0,0 +1,121 @@
+// Package client provides methods, values and urls for interacting with Yandex Tracker
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+
+	"resty.dev/v3"
+)
+
+// maxStreamedErrorBody limits the error body read from streamed responses
+const maxStreamedErrorBody = 1 << 20
+
+// Sentinel errors matched by APIError with errors.Is
+var (
+	ErrBadRequest         = errors.New("bad request")
+	ErrUnauthorized       = errors.New("unauthorized")
+	ErrForbidden          = errors.New("forbidden")
+	ErrNotFound           = errors.New("not found")
+	ErrConflict           = errors.New("conflict")
+	ErrPreconditionFailed = errors.New("precondition failed")
+	ErrRateLimited        = errors.New("rate limited")
+	ErrServerError        = errors.New("server error")
+)
+
+// APIError describes an error response returned by Yandex Tracker
+type APIError struct {
+	// HTTP status code of the response.
+	StatusCode int `json:"statusCode"`
+	// HTTP method of the failed request.
+	Method string `json:"-"`
+	// URL of the failed request.
+	URL string `json:"-"`
+	// Field-specific errors: field name -> error message.
+	Errors map[string]string `json:"errors"`
+	// General error messages.
+	ErrorMessages []string `json:"errorMessages"`
+	// Raw response body.
+	Body []byte `json:"-"`
+}
+
+// newAPIError builds APIError from the error response
+func newAPIError(res *resty.Response) *APIError {
+	apiErr := &APIError{}
+	body := res.Bytes()
+	if len(body) == 0 && res.Request != nil && res.Request.DoNotParseResponse && res.Body != nil {
+		// streamed responses are not read by resty
+		body, _ = io.ReadAll(io.LimitReader(res.Body, maxStreamedErrorBody))
+	}
+	if len(body) != 0 {
+		// Tracker answers with JSON in most cases, but proxies may return plain text or HTML
+		_ = json.Unmarshal(body, apiErr)
+	}
+	apiErr.StatusCode = res.StatusCode()
+	apiErr.Body = body
+	if res.Request != nil {
+		apiErr.Method = res.Request.Method
+		apiErr.URL = res.Request.URL
+		if res.Request.RawRequest != nil {
+			apiErr.URL = res.Request.RawRequest.URL.String()
+		}
+	}
+	return apiErr
+}
+
+// Error implements error interface
+func (e *APIError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "request %s %s failed with status code: %d %s", e.Method, e.URL, e.StatusCode, http.StatusText(e.StatusCode))
+	messages := e.Messages()
+	if len(messages) != 0 {
+		b.WriteString(": ")
+		b.WriteString(strings.Join(messages, "; "))
+	} else if len(e.Body) != 0 {
+		b.WriteString(". body: ")
+		b.Write(e.Body)
+	}
+	return b.String()
+}
+
+// Messages returns all of the error messages including field-specific ones
+func (e *APIError) Messages() []string {
+	messages := append([]string{}, e.ErrorMessages...)
+	fields := make([]string, 0, len(e.Errors))
+	for field := range e.Errors {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	for _, field := range fields {
+		messages = append(messages, field+": "+e.Errors[field])
+	}
+	return messages
+}
+
+// Is reports whether the error matches one of the sentinel errors by status code
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrBadRequest:
+		return e.StatusCode == http.StatusBadRequest
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict
+	case ErrPreconditionFailed:
+		return e.StatusCode == http.StatusPreconditionFailed
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrServerError:
+		return e.StatusCode >= http.StatusInternalServerError
+	}
+	return false
+}
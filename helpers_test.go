@@ -0,0 +1,78 @@
+package client_test
+
+import (
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	client "github.com/IndianMax03/yandex-tracker-go-client"
+	"github.com/IndianMax03/yandex-tracker-go-client/model"
+	"github.com/IndianMax03/yandex-tracker-go-client/trackertest"
+)
+
+// newTestClient starts trackertest server closed with the test and returns a client pointed at it
+func newTestClient(t *testing.T, opts ...client.Option) (*trackertest.Server, *client.Client) {
+	t.Helper()
+	srv := trackertest.NewServer()
+	t.Cleanup(srv.Close)
+	c, err := srv.Client(opts...)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	return srv, c
+}
+
+// addIssues stores n issues in the queue and returns their keys
+func addIssues(t *testing.T, srv *trackertest.Server, queue string, n int) []string {
+	t.Helper()
+	keys := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		issue, err := srv.AddIssue(model.IssueCreateRequest{Summary: "Issue", Queue: model.Queue{Key: queue}})
+		if err != nil {
+			t.Fatalf("failed to add issue: %v", err)
+		}
+		keys = append(keys, issue.Key)
+	}
+	return keys
+}
+
+// countRequests returns the number of requests received by the server with the method and path
+func countRequests(srv *trackertest.Server, method, path string) int {
+	count := 0
+	for _, req := range srv.Requests() {
+		if req.Method == method && req.Path == path {
+			count++
+		}
+	}
+	return count
+}
+
+// recordedWait describes a call of MetricsCollector.RateLimitWaited
+type recordedWait struct {
+	method      string
+	urlTemplate string
+	wait        time.Duration
+}
+
+// recordingMetrics is a MetricsCollector remembering rate limiter waits
+type recordingMetrics struct {
+	mu    sync.Mutex
+	waits []recordedWait
+}
+
+func (m *recordingMetrics) RequestStarted(string, string)                             {}
+func (m *recordingMetrics) RequestFinished(string, string, int, time.Duration, error) {}
+func (m *recordingMetrics) RequestRetried(string, string)                             {}
+
+func (m *recordingMetrics) RateLimitWaited(method, urlTemplate string, wait time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.waits = append(m.waits, recordedWait{method: method, urlTemplate: urlTemplate, wait: wait})
+}
+
+func (m *recordingMetrics) rateLimitWaits() []recordedWait {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.waits)
+}
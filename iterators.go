@@ -0,0 +1,158 @@
+// Package client provides methods, values and urls for interacting with Yandex Tracker
+package client
+
+import (
+	"context"
+	"iter"
+
+	model "github.com/IndianMax03/yandex-tracker-go-client/model"
+)
+
+// pageFetcher sends a request to get a single page of objects
+type pageFetcher[T any] func(ctx context.Context, pageReq *model.PageRequest) ([]T, *model.PageResponse, error)
+
+// pagesSeq lazily walks pages by number until X-Total-Pages is reached
+func pagesSeq[T any](ctx context.Context, perPage int, fetch pageFetcher[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		pageReq := model.PageRequest{
+			Page:    1,
+			PerPage: perPageOrDefault(perPage),
+		}
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+			items, pag, err := fetch(ctx, &pageReq)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			if len(items) == 0 || pageReq.Page >= pag.TotalPages {
+				return
+			}
+			pageReq.Page++
+		}
+	}
+}
+
+// fromIDSeq lazily walks pages starting each one after the last object of the previous page
+func fromIDSeq[T any](ctx context.Context, perPage int, fetch pageFetcher[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		pageReq := model.PageRequest{
+			PerPage: perPageOrDefault(perPage),
+		}
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+			items, pag, err := fetch(ctx, &pageReq)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			if pag.LastID <= 0 || pag.LastID == pageReq.FromID {
+				return
+			}
+			pageReq.FromID = pag.LastID
+		}
+	}
+}
+
+func perPageOrDefault(perPage int) int {
+	if perPage <= 0 {
+		return defaultPerPage
+	}
+	return perPage
+}
+
+// SearchIssuesSeq returns iterator over found issues fetching pages of perPage size on demand.
+// Iteration stops on the first error, which is yielded with zero issue.
+//
+//	for issue, err := range c.SearchIssuesSeq(ctx, req, 100) {
+//		if err != nil {
+//			return err
+//		}
+//		fmt.Println(issue.Key)
+//	}
+func (c *Client) SearchIssuesSeq(ctx context.Context, req *model.IssueSearchRequest, perPage int) iter.Seq2[model.IssueResponse, error] {
+	return pagesSeq(ctx, perPage, func(ctx context.Context, pageReq *model.PageRequest) ([]model.IssueResponse, *model.PageResponse, error) {
+		return c.SearchIssuesPageWithContext(ctx, req, pageReq)
+	})
+}
+
+// GetPrioritiesSeq returns iterator over priorities fetching pages of perPage size on demand
+func (c *Client) GetPrioritiesSeq(ctx context.Context, localized bool, perPage int) iter.Seq2[model.PriorityResponse, error] {
+	return pagesSeq(ctx, perPage, func(ctx context.Context, pageReq *model.PageRequest) ([]model.PriorityResponse, *model.PageResponse, error) {
+		return c.GetPrioritiesPageWithContext(ctx, localized, pageReq)
+	})
+}
+
+// GetCommentsSeq returns iterator over issue comments fetching pages of perPage size on demand
+func (c *Client) GetCommentsSeq(ctx context.Context, issueID string, commentExpand string, perPage int) iter.Seq2[model.CommentResponse, error] {
+	return fromIDSeq(ctx, perPage, func(ctx context.Context, pageReq *model.PageRequest) ([]model.CommentResponse, *model.PageResponse, error) {
+		return c.GetXCommentsAfterYWithContext(ctx, issueID, commentExpand, pageReq)
+	})
+}
+
+// GetUsersSeq returns iterator over users fetching pages of perPage size on demand
+func (c *Client) GetUsersSeq(ctx context.Context, perPage int) iter.Seq2[model.UserResponse, error] {
+	return pagesSeq(ctx, perPage, c.GetUsersPageWithContext)
+}
+
+// GetComponentsSeq returns iterator over components fetching pages of perPage size on demand
+func (c *Client) GetComponentsSeq(ctx context.Context, perPage int) iter.Seq2[model.ComponentResponse, error] {
+	return pagesSeq(ctx, perPage, c.GetComponentsPageWithContext)
+}
+
+// SearchWorklogsSeq returns iterator over found records of spent time fetching pages of perPage size on demand
+func (c *Client) SearchWorklogsSeq(ctx context.Context, req *model.WorklogSearchRequest, perPage int) iter.Seq2[model.WorklogResponse, error] {
+	return pagesSeq(ctx, perPage, func(ctx context.Context, pageReq *model.PageRequest) ([]model.WorklogResponse, *model.PageResponse, error) {
+		return c.SearchWorklogsPageWithContext(ctx, req, pageReq)
+	})
+}
+
+// GetIssueChangelogSeq returns iterator over the issue changelog fetching pages of req.PerPage size on demand
+// starting after the entry with ID req.FromID. Nil req iterates over the whole changelog.
+func (c *Client) GetIssueChangelogSeq(ctx context.Context, issueID string, req *model.ChangelogRequest) iter.Seq2[model.ChangelogResponse, error] {
+	return func(yield func(model.ChangelogResponse, error) bool) {
+		var pageReq model.ChangelogRequest
+		if req != nil {
+			pageReq = *req
+		}
+		pageReq.PerPage = perPageOrDefault(pageReq.PerPage)
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(model.ChangelogResponse{}, err)
+				return
+			}
+			entries, err := c.GetIssueChangelogWithContext(ctx, issueID, &pageReq)
+			if err != nil {
+				yield(model.ChangelogResponse{}, err)
+				return
+			}
+			for _, entry := range entries {
+				if !yield(entry, nil) {
+					return
+				}
+			}
+			if len(entries) < pageReq.PerPage || entries[len(entries)-1].ID == pageReq.FromID {
+				return
+			}
+			pageReq.FromID = entries[len(entries)-1].ID
+		}
+	}
+}
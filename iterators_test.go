@@ -0,0 +1,130 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/IndianMax03/yandex-tracker-go-client/model"
+)
+
+func TestSearchIssuesSeqFetchesLazily(t *testing.T) {
+	srv, c := newTestClient(t)
+	keys := addIssues(t, srv, "TEST", 5)
+
+	var found []string
+	for issue, err := range c.SearchIssuesSeq(context.Background(), &model.IssueSearchRequest{Queue: "TEST"}, 2) {
+		if err != nil {
+			t.Fatalf("iteration failed: %v", err)
+		}
+		found = append(found, issue.Key)
+		if len(found) == 3 {
+			break
+		}
+	}
+	if !slices.Equal(found, keys[:3]) {
+		t.Errorf("got keys %v, want %v", found, keys[:3])
+	}
+	if got := countRequests(srv, http.MethodPost, "/issues/_search"); got != 2 {
+		t.Errorf("got %d pages fetched, want 2", got)
+	}
+
+	found = found[:0]
+	for issue, err := range c.SearchIssuesSeq(context.Background(), &model.IssueSearchRequest{Queue: "TEST"}, 2) {
+		if err != nil {
+			t.Fatalf("iteration failed: %v", err)
+		}
+		found = append(found, issue.Key)
+	}
+	if !slices.Equal(found, keys) {
+		t.Errorf("got keys %v, want %v", found, keys)
+	}
+	if got := countRequests(srv, http.MethodPost, "/issues/_search"); got != 5 {
+		t.Errorf("got %d pages fetched in total, want 5", got)
+	}
+}
+
+func TestSearchIssuesSeqCanceled(t *testing.T) {
+	srv, c := newTestClient(t)
+	addIssues(t, srv, "TEST", 5)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var items int
+	var lastErr error
+	for _, err := range c.SearchIssuesSeq(ctx, &model.IssueSearchRequest{Queue: "TEST"}, 2) {
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		items++
+		cancel()
+	}
+	if !errors.Is(lastErr, context.Canceled) {
+		t.Errorf("got error %v, want context.Canceled", lastErr)
+	}
+	if items != 2 {
+		t.Errorf("got %d issues, want the fetched page only", items)
+	}
+	if got := countRequests(srv, http.MethodPost, "/issues/_search"); got != 1 {
+		t.Errorf("got %d pages fetched, want 1", got)
+	}
+
+	for _, err := range c.SearchIssuesSeq(ctx, &model.IssueSearchRequest{Queue: "TEST"}, 2) {
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("got error %v from canceled iteration, want context.Canceled", err)
+		}
+	}
+	if got := countRequests(srv, http.MethodPost, "/issues/_search"); got != 1 {
+		t.Errorf("got %d pages fetched, want none after cancellation", got)
+	}
+}
+
+func TestGetCommentsSeq(t *testing.T) {
+	srv, c := newTestClient(t)
+	key := addIssues(t, srv, "TEST", 1)[0]
+	var want []string
+	for i := range 5 {
+		text := "comment " + strconv.Itoa(i)
+		if _, err := c.CreateComment(key, &model.CommentRequest{Text: text}); err != nil {
+			t.Fatalf("CreateComment failed: %v", err)
+		}
+		want = append(want, text)
+	}
+	path := "/issues/" + key + "/comments"
+
+	var texts []string
+	for comment, err := range c.GetCommentsSeq(context.Background(), key, "", 2) {
+		if err != nil {
+			t.Fatalf("iteration failed: %v", err)
+		}
+		texts = append(texts, comment.Text)
+	}
+	if !slices.Equal(texts, want) {
+		t.Errorf("got comments %v, want %v", texts, want)
+	}
+	if got := countRequests(srv, http.MethodGet, path); got != 4 {
+		t.Errorf("got %d pages fetched, want 3 pages and the empty one ending iteration", got)
+	}
+
+	before := countRequests(srv, http.MethodGet, path)
+	texts = texts[:0]
+	for comment, err := range c.GetCommentsSeq(context.Background(), key, "", 2) {
+		if err != nil {
+			t.Fatalf("iteration failed: %v", err)
+		}
+		texts = append(texts, comment.Text)
+		if len(texts) == 3 {
+			break
+		}
+	}
+	if !slices.Equal(texts, want[:3]) {
+		t.Errorf("got comments %v, want %v", texts, want[:3])
+	}
+	if got := countRequests(srv, http.MethodGet, path) - before; got != 2 {
+		t.Errorf("got %d pages fetched before break, want 2", got)
+	}
+}
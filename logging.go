@@ -0,0 +1,160 @@
+// Package client provides methods, values and urls for interacting with Yandex Tracker
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"regexp"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"resty.dev/v3"
+)
+
+const redacted = "[REDACTED]"
+
+// Patterns of secrets removed from logged text. They match credentials by their shape
+// or by the field and header carrying them, so ordinary words like "OAuth" are kept.
+var (
+	secretFieldPattern = regexp.MustCompile(`(?i)("(?:iamToken|access_token|refresh_token|oauth_token|token|jwt|private_key|password)"\s*:\s*")(?:[^"\\]|\\.)*"`)
+	authHeaderPattern  = regexp.MustCompile(`(?i)(\bAuthorization"?\s*[:=]\s*\[?"?(?:OAuth|Bearer)\s+)[^\s"',;\]]+`)
+	iamTokenPattern    = regexp.MustCompile(`\bt1\.[A-Za-z0-9_\-]{20,}(?:\.[A-Za-z0-9_\-=]+)*`)
+	oauthTokenPattern  = regexp.MustCompile(`\by[0-3]_[A-Za-z0-9_\-]{16,}`)
+	jwtPattern         = regexp.MustCompile(`\beyJ[A-Za-z0-9_\-]{10,}\.[A-Za-z0-9_\-]{10,}\.[A-Za-z0-9_\-]{10,}`)
+)
+
+// Redact removes OAuth, IAM and service account tokens and other secrets from the text
+func Redact(text string) string {
+	text = secretFieldPattern.ReplaceAllString(text, `${1}`+redacted+`"`)
+	text = authHeaderPattern.ReplaceAllString(text, `${1}`+redacted)
+	text = iamTokenPattern.ReplaceAllString(text, redacted)
+	text = jwtPattern.ReplaceAllString(text, redacted)
+	return oauthTokenPattern.ReplaceAllString(text, redacted)
+}
+
+// redactDebugLog removes secrets from resty debug log enabled by SetDebug
+func redactDebugLog(dl *resty.DebugLog) {
+	if dl.Request != nil {
+		dl.Request.Header.Del("Authorization")
+		dl.Request.Body = Redact(dl.Request.Body)
+		dl.Request.CurlCmd = Redact(dl.Request.CurlCmd)
+	}
+	if dl.Response != nil {
+		dl.Response.Body = Redact(dl.Response.Body)
+	}
+}
+
+func loggerOrDiscard(logger *slog.Logger) *slog.Logger {
+	if logger == nil {
+		return slog.New(slog.DiscardHandler)
+	}
+	return logger
+}
+
+// SetLogger sets structured logger of requests. Nil logger disables logging.
+// Successful requests are logged with Debug level, retries and 4xx responses with Warn,
+// 5xx responses and network errors with Error. Authorization data is never logged.
+func (c *Client) SetLogger(logger *slog.Logger) {
+	c.logger = loggerOrDiscard(logger)
+}
+
+// SetBodyLogLimit enables logging of request and response bodies with Debug level,
+// truncated to limit bytes. Zero limit disables body logging.
+func (c *Client) SetBodyLogLimit(limit int) {
+	c.bodyLogLimit = limit
+}
+
+// logged wraps handler writing a record per request
+func (c *Client) logged(next Handler) Handler {
+	return func(ctx context.Context, req *Request) (*resty.Response, error) {
+		start := time.Now()
+		res, err := next(ctx, req)
+		level := slog.LevelDebug
+		attrs := []slog.Attr{
+			slog.String("method", req.Method),
+			slog.String("url_template", req.ResourceURL),
+			slog.Any("path_params", req.PathParams),
+			slog.Duration("duration", time.Since(start)),
+			slog.Int("attempts", req.Attempts()),
+		}
+		switch {
+		case err != nil:
+			level = slog.LevelError
+			attrs = append(attrs, slog.String("error", Redact(err.Error())))
+		case res.StatusCode() >= 500:
+			level = slog.LevelError
+		case res.IsError():
+			level = slog.LevelWarn
+		}
+		if !c.logger.Enabled(ctx, level) {
+			return res, err
+		}
+		if res != nil {
+			attrs = append(attrs, slog.Int("status", res.StatusCode()))
+			if requestID := res.Header().Get("X-Request-Id"); requestID != "" {
+				attrs = append(attrs, slog.String("request_id", requestID))
+			}
+		}
+		if c.bodyLogLimit > 0 && c.logger.Enabled(ctx, slog.LevelDebug) {
+			attrs = append(attrs, c.bodyAttrs(req, res, err)...)
+		}
+		c.logger.LogAttrs(ctx, level, "tracker request", attrs...)
+		return res, err
+	}
+}
+
+// bodyAttrs returns redacted and truncated request and response bodies
+func (c *Client) bodyAttrs(req *Request, res *resty.Response, err error) []slog.Attr {
+	var attrs []slog.Attr
+	if req.Body != nil {
+		if body, marshalErr := json.Marshal(req.Body); marshalErr == nil {
+			attrs = append(attrs, slog.String("request_body", c.truncate(body)))
+		}
+	}
+	if err != nil || res == nil {
+		return attrs
+	}
+	if res.IsError() {
+		// the error body is not decoded, so it is read and kept for APIError
+		return append(attrs, slog.String("response_body", c.truncate(res.Bytes())))
+	}
+	if req.Result != nil {
+		if body, marshalErr := json.Marshal(req.Result); marshalErr == nil {
+			attrs = append(attrs, slog.String("response_body", c.truncate(body)))
+		}
+	}
+	return attrs
+}
+
+func (c *Client) truncate(body []byte) string {
+	text := Redact(string(body))
+	if len(text) <= c.bodyLogLimit {
+		return text
+	}
+	limit := c.bodyLogLimit
+	for limit > 0 && !utf8.RuneStart(text[limit]) {
+		limit--
+	}
+	return text[:limit] + "...(" + strconv.Itoa(len(text)) + " bytes)"
+}
+
+// logRetry writes a record about the attempt that is going to be retried
+func (c *Client) logRetry(ctx context.Context, req *Request, res *resty.Response, err error, delay time.Duration) {
+	if !c.logger.Enabled(ctx, slog.LevelWarn) {
+		return
+	}
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("url_template", req.ResourceURL),
+		slog.Int("attempt", req.Attempts()),
+		slog.Duration("delay", delay),
+	}
+	if err != nil {
+		attrs = append(attrs, slog.String("error", Redact(err.Error())))
+	} else if res != nil {
+		attrs = append(attrs, slog.Int("status", res.StatusCode()))
+	}
+	c.logger.LogAttrs(ctx, slog.LevelWarn, "retrying tracker request", attrs...)
+}
@@ -0,0 +1,59 @@
+// Package client provides methods, values and urls for interacting with Yandex Tracker
+package client
+
+import (
+	"context"
+	"time"
+
+	"resty.dev/v3"
+)
+
+// MetricsCollector receives metrics of requests labeled by HTTP method and resource URL template
+// (e.g. /issues/{issue_id}/comments). Implementations must be safe for concurrent use.
+// See package prommetrics for Prometheus implementation.
+type MetricsCollector interface {
+	// RequestStarted is called before the request is sent for the first time.
+	RequestStarted(method, urlTemplate string)
+	// RequestFinished is called once the request is done including retries.
+	// Status code is 0 if no response was received.
+	RequestFinished(method, urlTemplate string, statusCode int, duration time.Duration, err error)
+	// RequestRetried is called before every retry.
+	RequestRetried(method, urlTemplate string)
+	// RateLimitWaited is called after the request waited on the client-side rate limiter.
+	RateLimitWaited(method, urlTemplate string, wait time.Duration)
+}
+
+type nopMetricsCollector struct{}
+
+func (nopMetricsCollector) RequestStarted(string, string)                             {}
+func (nopMetricsCollector) RequestFinished(string, string, int, time.Duration, error) {}
+func (nopMetricsCollector) RequestRetried(string, string)                             {}
+func (nopMetricsCollector) RateLimitWaited(string, string, time.Duration)             {}
+
+func metricsOrNop(metrics MetricsCollector) MetricsCollector {
+	if metrics == nil {
+		return nopMetricsCollector{}
+	}
+	return metrics
+}
+
+// SetMetricsCollector sets the receiver of request metrics. Nil collector disables metrics.
+func (c *Client) SetMetricsCollector(metrics MetricsCollector) {
+	c.metrics = metricsOrNop(metrics)
+}
+
+// measured wraps handler reporting in-flight requests, latency and status codes
+func (c *Client) measured(next Handler) Handler {
+	return func(ctx context.Context, req *Request) (*resty.Response, error) {
+		method, urlTemplate := req.Method, req.ResourceURL
+		c.metrics.RequestStarted(method, urlTemplate)
+		start := time.Now()
+		res, err := next(ctx, req)
+		var statusCode int
+		if res != nil {
+			statusCode = res.StatusCode()
+		}
+		c.metrics.RequestFinished(method, urlTemplate, statusCode, time.Since(start), err)
+		return res, err
+	}
+}
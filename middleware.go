@@ -0,0 +1,159 @@
+// Package client provides methods, values and urls for interacting with Yandex Tracker
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"resty.dev/v3"
+)
+
+// Request describes a request passing through the middleware chain.
+// Middlewares may modify any field before calling the next handler.
+type Request struct {
+	// HTTP method.
+	Method string
+	// Resource URL template from urls.go, e.g. /issues/{issue_id}/comments.
+	ResourceURL string
+	// Query parameters.
+	QueryParams map[string]string
+	// Query parameters with multiple values.
+	MultiplyQueryParams url.Values
+	// Values substituted into ResourceURL.
+	PathParams map[string]string
+	// Additional request headers.
+	Header http.Header
+	// Request body. Nil for multipart requests.
+	Body any
+	// Multipart field of multipart requests.
+	Multipart *resty.MultipartField
+	// Pointer the response body is decoded into on success.
+	Result any
+
+	// stream leaves the response body unread for streaming decoding, Result is nil then
+	stream bool
+
+	attempts int
+}
+
+// Attempts returns the number of attempts made to send the request including retries
+func (r *Request) Attempts() int {
+	return r.attempts
+}
+
+// Handler sends the request to Yandex Tracker and returns the response.
+// Result of the request is decoded when the handler returns.
+type Handler func(ctx context.Context, req *Request) (*resty.Response, error)
+
+// Middleware wraps Handler to observe, modify or short-circuit requests. A middleware that
+// short-circuits returns a response built with Client.NewResponse without calling next.
+// Returning nil response without error fails the request with ErrNoResponse.
+//
+// Short-circuit:
+//
+//	func(next client.Handler) client.Handler {
+//		return func(ctx context.Context, req *client.Request) (*resty.Response, error) {
+//			if req.ResourceURL == "/myself" {
+//				return c.NewResponse(req, http.StatusOK, nil, []byte(`{"login":"robot"}`))
+//			}
+//			return next(ctx, req)
+//		}
+//	}
+//
+// Header injection:
+//
+//	func(next client.Handler) client.Handler {
+//		return func(ctx context.Context, req *client.Request) (*resty.Response, error) {
+//			req.Header.Set("X-Request-Source", "billing")
+//			return next(ctx, req)
+//		}
+//	}
+//
+// Logging of mutating calls:
+//
+//	func(next client.Handler) client.Handler {
+//		return func(ctx context.Context, req *client.Request) (*resty.Response, error) {
+//			start := time.Now()
+//			res, err := next(ctx, req)
+//			if req.Method != resty.MethodGet {
+//				status := "error: " + fmt.Sprint(err)
+//				if err == nil {
+//					status = res.Status()
+//				}
+//				log.Printf("%s %s %v: %s in %v", req.Method, req.ResourceURL, req.PathParams, status, time.Since(start))
+//			}
+//			return res, err
+//		}
+//	}
+type Middleware func(next Handler) Handler
+
+// Use appends middlewares to the chain. Middlewares run in the order they were added:
+// the first one sees the request first and the response last. Retries happen inside the chain,
+// so every middleware is called once per SendRequest. Use must not be called concurrently with requests.
+func (c *Client) Use(middlewares ...Middleware) {
+	c.middlewares = append(c.middlewares, middlewares...)
+}
+
+// ErrNoResponse is returned when a middleware returns neither a response nor an error
+var ErrNoResponse = errors.New("middleware returned no response")
+
+// handle passes the request through the middleware chain
+func (c *Client) handle(ctx context.Context, req *Request) (*resty.Response, error) {
+	res, err := chain(c.middlewares, c.cached(c.traced(c.measured(c.logged(c.execute)))))(ctx, req)
+	if res == nil && err == nil {
+		return nil, fmt.Errorf("%w: %s %s", ErrNoResponse, req.Method, req.ResourceURL)
+	}
+	return res, err
+}
+
+// NewResponse builds a response for middlewares that answer without calling the next handler.
+// Body of a successful response is decoded into req.Result unless the request is streamed.
+// The response body stays readable, so String and Bytes work as for the real responses.
+func (c *Client) NewResponse(req *Request, statusCode int, header http.Header, body []byte) (*resty.Response, error) {
+	if statusCode >= http.StatusOK && statusCode < http.StatusMultipleChoices && req.Result != nil && !req.stream && len(body) != 0 {
+		if err := json.Unmarshal(body, req.Result); err != nil {
+			return nil, fmt.Errorf("failed to decode response: %w", err)
+		}
+	}
+	if header == nil {
+		header = http.Header{}
+	}
+	restyReq := c.restyClient.R().SetDoNotParseResponse(req.stream)
+	restyReq.Method = req.Method
+	restyReq.URL = c.restyClient.BaseURL() + resolvePath(req)
+	return &resty.Response{
+		Request: restyReq,
+		Body:    io.NopCloser(bytes.NewReader(body)),
+		RawResponse: &http.Response{
+			Status:        fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode)),
+			StatusCode:    statusCode,
+			Header:        header,
+			Body:          io.NopCloser(bytes.NewReader(body)),
+			ContentLength: int64(len(body)),
+		},
+	}, nil
+}
+
+// resolvePath returns the resource URL with substituted path params
+func resolvePath(req *Request) string {
+	path := req.ResourceURL
+	for name, value := range req.PathParams {
+		path = strings.ReplaceAll(path, "{"+name+"}", url.PathEscape(value))
+	}
+	return path
+}
+
+// chain wraps handler with middlewares, so the first middleware is the outermost
+func chain(middlewares []Middleware, handler Handler) Handler {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		handler = middlewares[i](handler)
+	}
+	return handler
+}
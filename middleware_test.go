@@ -0,0 +1,105 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	client "github.com/IndianMax03/yandex-tracker-go-client"
+	"github.com/IndianMax03/yandex-tracker-go-client/model"
+	"resty.dev/v3"
+)
+
+func TestMiddlewareOrder(t *testing.T) {
+	_, c := newTestClient(t)
+	var calls []string
+	record := func(name string) client.Middleware {
+		return func(next client.Handler) client.Handler {
+			return func(ctx context.Context, req *client.Request) (*resty.Response, error) {
+				calls = append(calls, name+" before")
+				res, err := next(ctx, req)
+				calls = append(calls, name+" after")
+				return res, err
+			}
+		}
+	}
+	c.Use(record("first"), record("second"))
+
+	if _, err := c.GetMyself(); err != nil {
+		t.Fatalf("GetMyself failed: %v", err)
+	}
+	want := "first before, second before, second after, first after"
+	if got := strings.Join(calls, ", "); got != want {
+		t.Errorf("got calls %q, want %q", got, want)
+	}
+}
+
+func TestMiddlewareShortCircuit(t *testing.T) {
+	srv, c := newTestClient(t)
+	var body string
+	c.Use(func(next client.Handler) client.Handler {
+		return func(ctx context.Context, req *client.Request) (*resty.Response, error) {
+			res, err := next(ctx, req)
+			if err == nil {
+				body = res.String()
+			}
+			return res, err
+		}
+	}, func(next client.Handler) client.Handler {
+		return func(ctx context.Context, req *client.Request) (*resty.Response, error) {
+			switch req.ResourceURL {
+			case "/myself":
+				return c.NewResponse(req, http.StatusOK, nil, []byte(`{"login":"robot"}`))
+			case "/users/{login_or_user_id}":
+				return c.NewResponse(req, http.StatusNotFound, nil, []byte(`{"errorMessages":["no such user"],"statusCode":404}`))
+			}
+			return next(ctx, req)
+		}
+	})
+
+	user, err := c.GetMyself()
+	if err != nil {
+		t.Fatalf("GetMyself failed: %v", err)
+	}
+	if user.Login != "robot" {
+		t.Errorf("got login %q, want robot", user.Login)
+	}
+	if body != `{"login":"robot"}` {
+		t.Errorf("outer middleware read body %q", body)
+	}
+
+	_, err = c.GetUser("someone", 0)
+	var apiErr *client.APIError
+	if !errors.As(err, &apiErr) || !errors.Is(err, client.ErrNotFound) {
+		t.Fatalf("got error %v, want APIError with 404", err)
+	}
+	if messages := apiErr.Messages(); len(messages) != 1 || messages[0] != "no such user" {
+		t.Errorf("got messages %v", messages)
+	}
+	if !strings.Contains(apiErr.URL, "/users/someone") {
+		t.Errorf("got URL %q, want it to contain the resolved path", apiErr.URL)
+	}
+	if got := len(srv.Requests()); got != 0 {
+		t.Errorf("got %d requests sent to the server, want 0", got)
+	}
+}
+
+func TestMiddlewareNilResponse(t *testing.T) {
+	_, c := newTestClient(t)
+	c.Use(func(next client.Handler) client.Handler {
+		return func(ctx context.Context, req *client.Request) (*resty.Response, error) {
+			return nil, nil
+		}
+	})
+
+	_, err := c.GetIssue("TEST-1", false, false)
+	if !errors.Is(err, client.ErrNoResponse) {
+		t.Fatalf("got error %v, want ErrNoResponse", err)
+	}
+	_, err = c.SearchIssuesStream(&model.IssueSearchRequest{Queue: "TEST"}, &model.PageRequest{}, func(*model.IssueResponse) error { return nil })
+	if !errors.Is(err, client.ErrNoResponse) {
+		t.Fatalf("got error %v from stream, want ErrNoResponse", err)
+	}
+}
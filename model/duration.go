@@ -0,0 +1,106 @@
+// Package model contains an entities for exchanging information with the Yandex Tracker API
+package model
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TimeLayout is the layout of date and time values sent and received by Tracker
+const TimeLayout = "2006-01-02T15:04:05.000-0700"
+
+// Working time units used by Tracker for time tracking
+const (
+	WorkDay  = 8 * time.Hour
+	WorkWeek = 5 * WorkDay
+)
+
+// ParseTime parses date and time in TimeLayout or RFC 3339. Empty string is parsed as zero time.
+func ParseTime(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(TimeLayout, s)
+	if err != nil {
+		return time.Parse(time.RFC3339Nano, s)
+	}
+	return t, nil
+}
+
+// FormatTime formats date and time in TimeLayout. Zero time is formatted as empty string.
+func FormatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(TimeLayout)
+}
+
+// ParseDuration parses ISO 8601 duration like P1W2DT3H30M used by Tracker for time tracking.
+// Weeks and days are working ones, see WorkWeek and WorkDay. Years and months are not supported.
+func ParseDuration(s string) (time.Duration, error) {
+	rest, ok := strings.CutPrefix(s, "P")
+	if !ok || rest == "" || strings.HasSuffix(rest, "T") {
+		return 0, fmt.Errorf("invalid duration %q", s)
+	}
+	var result time.Duration
+	inTime := false
+	for rest != "" {
+		if rest[0] == 'T' {
+			if inTime {
+				return 0, fmt.Errorf("invalid duration %q", s)
+			}
+			inTime = true
+			rest = rest[1:]
+			continue
+		}
+		end := strings.IndexFunc(rest, func(r rune) bool { return (r < '0' || r > '9') && r != '.' && r != ',' })
+		if end <= 0 {
+			return 0, fmt.Errorf("invalid duration %q", s)
+		}
+		value, err := strconv.ParseFloat(strings.Replace(rest[:end], ",", ".", 1), 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q: %w", s, err)
+		}
+		var unit time.Duration
+		switch designator := rest[end]; {
+		case !inTime && designator == 'W':
+			unit = WorkWeek
+		case !inTime && designator == 'D':
+			unit = WorkDay
+		case inTime && designator == 'H':
+			unit = time.Hour
+		case inTime && designator == 'M':
+			unit = time.Minute
+		case inTime && designator == 'S':
+			unit = time.Second
+		default:
+			return 0, fmt.Errorf("unsupported unit %q of duration %q", designator, s)
+		}
+		result += time.Duration(value * float64(unit))
+		rest = rest[end+1:]
+	}
+	return result, nil
+}
+
+// FormatDuration formats non-negative duration in ISO 8601 using hours, minutes and seconds, e.g. PT1H30M
+func FormatDuration(d time.Duration) string {
+	if d <= 0 {
+		return "PT0S"
+	}
+	var b strings.Builder
+	b.WriteString("PT")
+	if hours := d / time.Hour; hours > 0 {
+		b.WriteString(strconv.FormatInt(int64(hours), 10) + "H")
+		d -= hours * time.Hour
+	}
+	if minutes := d / time.Minute; minutes > 0 {
+		b.WriteString(strconv.FormatInt(int64(minutes), 10) + "M")
+		d -= minutes * time.Minute
+	}
+	if d > 0 {
+		b.WriteString(strconv.FormatFloat(d.Seconds(), 'f', -1, 64) + "S")
+	}
+	return b.String()
+}
@@ -0,0 +1,86 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    time.Duration
+		wantErr bool
+	}{
+		{in: "P1W2DT3H30M", want: WorkWeek + 2*WorkDay + 3*time.Hour + 30*time.Minute},
+		{in: "P3D", want: 3 * WorkDay},
+		{in: "PT45S", want: 45 * time.Second},
+		{in: "PT1.5H", want: 90 * time.Minute},
+		{in: "P0,5D", want: 4 * time.Hour},
+		{in: "PT0.25S", want: 250 * time.Millisecond},
+		{in: "PT0S", want: 0},
+		{in: "", wantErr: true},
+		{in: "P", wantErr: true},
+		{in: "PT", wantErr: true},
+		{in: "P1DT", wantErr: true},
+		{in: "P1M", wantErr: true},
+		{in: "P1Y", wantErr: true},
+		{in: "PT1D", wantErr: true},
+		{in: "P1H", wantErr: true},
+		{in: "PTT1H", wantErr: true},
+		{in: "PTH", wantErr: true},
+		{in: "1H", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseDuration(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("got %v, want error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("got error %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatDuration(t *testing.T) {
+	tests := []struct {
+		in   time.Duration
+		want string
+	}{
+		{0, "PT0S"},
+		{-time.Hour, "PT0S"},
+		{30 * time.Second, "PT30S"},
+		{90 * time.Minute, "PT1H30M"},
+		{WorkDay, "PT8H"},
+		{2*time.Hour + 1500*time.Millisecond, "PT2H1.5S"},
+	}
+	for _, tt := range tests {
+		if got := FormatDuration(tt.in); got != tt.want {
+			t.Errorf("FormatDuration(%v) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestDurationRoundTrip(t *testing.T) {
+	for _, d := range []time.Duration{
+		time.Second,
+		90 * time.Minute,
+		WorkWeek + 2*WorkDay + 3*time.Hour + 30*time.Minute,
+		time.Hour + 250*time.Millisecond,
+	} {
+		got, err := ParseDuration(FormatDuration(d))
+		if err != nil {
+			t.Fatalf("ParseDuration(FormatDuration(%v)) failed: %v", d, err)
+		}
+		if got != d {
+			t.Errorf("got %v after round trip of %v", got, d)
+		}
+	}
+}
@@ -0,0 +1,154 @@
+// Package model contains an entities for exchanging information with the Yandex Tracker API
+package model
+
+import (
+	"encoding/json"
+	"slices"
+)
+
+// ChangelogRequest describes request to get a page of the issue changelog.
+type ChangelogRequest struct {
+	// Optional
+
+	// IDs of the fields whose changes are returned, e.g. ChangelogFieldStatus. Empty value returns changes of all fields.
+	Fields []string
+	// Types of the changes returned, e.g. ChangelogTypeIssueWorkflow. Empty value returns changes of all types.
+	Types []string
+	// Entries per page.
+	PerPage int
+	// ID of the entry after which the requested page will begin.
+	FromID string
+}
+
+// ChangelogIssue describes the changed issue
+type ChangelogIssue struct {
+	ObjectBaseResponse
+	Key string `json:"key"`
+}
+
+// ChangelogResponse describes an entry of the issue changelog.
+type ChangelogResponse struct {
+	// Entry ID.
+	ID string `json:"id"`
+	// Link to the entry.
+	Self string `json:"self"`
+	// Block with information about the issue.
+	Issue ChangelogIssue `json:"issue"`
+	// Date and time of the change.
+	UpdatedAt string `json:"updatedAt"`
+	// Block with information about the user who made the change.
+	UpdatedBy UpdatedBy `json:"updatedBy"`
+	// Type of the change, e.g. ChangelogTypeIssueUpdated.
+	Type string `json:"type"`
+	// Way the change was made, e.g. front or api.
+	Transport string `json:"transport"`
+	// Changes of the issue fields.
+	Fields []FieldChange `json:"fields"`
+}
+
+// ChangelogField describes the changed field
+type ChangelogField ObjectBaseResponse
+
+// FieldChange describes change of an issue field. From and To keep the raw values,
+// well-known fields are also decoded into the typed diff matching Field.ID, the other diffs are nil.
+type FieldChange struct {
+	// Block with information about the field.
+	Field ChangelogField `json:"field"`
+	// Raw value before the change, null if the field was empty.
+	From json.RawMessage `json:"from"`
+	// Raw value after the change, null if the field was cleared.
+	To json.RawMessage `json:"to"`
+
+	// Change of the status.
+	Status *StatusDiff `json:"-"`
+	// Change of the assignee.
+	Assignee *AssigneeDiff `json:"-"`
+	// Change of the priority.
+	Priority *PriorityDiff `json:"-"`
+	// Change of the tags.
+	Tags *TagsDiff `json:"-"`
+}
+
+// StatusDiff describes change of the issue status. Nil values mean no status.
+type StatusDiff struct {
+	From *IssueStatus
+	To   *IssueStatus
+}
+
+// AssigneeDiff describes change of the issue assignee. Nil values mean no assignee.
+type AssigneeDiff struct {
+	From *IssueAssignee
+	To   *IssueAssignee
+}
+
+// PriorityDiff describes change of the issue priority. Nil values mean no priority.
+type PriorityDiff struct {
+	From *IssuePriority
+	To   *IssuePriority
+}
+
+// TagsDiff describes change of the issue tags
+type TagsDiff struct {
+	From []string
+	To   []string
+	// Tags present in To but not in From.
+	Added []string
+	// Tags present in From but not in To.
+	Removed []string
+}
+
+// UnmarshalJSON decodes the change and its typed diff
+func (c *FieldChange) UnmarshalJSON(data []byte) error {
+	type rawFieldChange FieldChange
+	var raw rawFieldChange
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	*c = FieldChange(raw)
+	var err error
+	switch c.Field.ID {
+	case ChangelogFieldStatus:
+		c.Status = &StatusDiff{}
+		err = decodeDiff(c.From, c.To, &c.Status.From, &c.Status.To)
+	case ChangelogFieldAssignee:
+		c.Assignee = &AssigneeDiff{}
+		err = decodeDiff(c.From, c.To, &c.Assignee.From, &c.Assignee.To)
+	case ChangelogFieldPriority:
+		c.Priority = &PriorityDiff{}
+		err = decodeDiff(c.From, c.To, &c.Priority.From, &c.Priority.To)
+	case ChangelogFieldTags:
+		c.Tags = &TagsDiff{}
+		if err = decodeDiff(c.From, c.To, &c.Tags.From, &c.Tags.To); err == nil {
+			c.Tags.Added = difference(c.Tags.To, c.Tags.From)
+			c.Tags.Removed = difference(c.Tags.From, c.Tags.To)
+		}
+	}
+	return err
+}
+
+// decodeDiff decodes non-empty raw values into from and to
+func decodeDiff[T any](rawFrom, rawTo json.RawMessage, from, to *T) error {
+	for _, v := range []struct {
+		raw  json.RawMessage
+		dest *T
+	}{{rawFrom, from}, {rawTo, to}} {
+		if len(v.raw) == 0 || string(v.raw) == "null" {
+			continue
+		}
+		if err := json.Unmarshal(v.raw, v.dest); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// difference returns values of a missing in b
+func difference(a, b []string) []string {
+	var result []string
+	for _, value := range a {
+		if !slices.Contains(b, value) {
+			result = append(result, value)
+		}
+	}
+	return result
+}
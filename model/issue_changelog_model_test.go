@@ -0,0 +1,66 @@
+package model
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestFieldChangeJSON(t *testing.T) {
+	data := []byte(`[
+		{"field": {"id": "status", "display": "Status"}, "from": {"id": "1", "key": "open", "display": "Open"}, "to": {"id": "2", "key": "inProgress", "display": "In progress"}},
+		{"field": {"id": "assignee", "display": "Assignee"}, "from": null, "to": {"id": "42", "display": "Test User"}},
+		{"field": {"id": "assignee", "display": "Assignee"}, "from": {"id": "42", "display": "Test User"}, "to": null},
+		{"field": {"id": "priority", "display": "Priority"}, "from": {"id": "3", "key": "normal", "display": "Normal"}, "to": {"id": "4", "key": "critical", "display": "Critical"}},
+		{"field": {"id": "tags", "display": "Tags"}, "from": ["a", "b"], "to": ["b", "c"]},
+		{"field": {"id": "summary", "display": "Summary"}, "from": "Old", "to": "New"}
+	]`)
+
+	var got []FieldChange
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("failed to unmarshal: %v", err)
+	}
+	if len(got) != 6 {
+		t.Fatalf("got %d changes, want 6", len(got))
+	}
+
+	status := got[0].Status
+	if status == nil || status.From == nil || status.To == nil || status.From.Key != "open" || status.To.Key != "inProgress" {
+		t.Errorf("got status diff %+v", status)
+	}
+	if assignee := got[1].Assignee; assignee == nil || assignee.From != nil || assignee.To == nil || assignee.To.ID != "42" {
+		t.Errorf("got assignee diff %+v for the assigned issue", assignee)
+	}
+	if assignee := got[2].Assignee; assignee == nil || assignee.From == nil || assignee.From.ID != "42" || assignee.To != nil {
+		t.Errorf("got assignee diff %+v for the unassigned issue", assignee)
+	}
+	priority := got[3].Priority
+	if priority == nil || priority.From == nil || priority.To == nil || priority.From.Key != "normal" || priority.To.Key != "critical" {
+		t.Errorf("got priority diff %+v", priority)
+	}
+	want := &TagsDiff{From: []string{"a", "b"}, To: []string{"b", "c"}, Added: []string{"c"}, Removed: []string{"a"}}
+	if !reflect.DeepEqual(got[4].Tags, want) {
+		t.Errorf("got tags diff %+v, want %+v", got[4].Tags, want)
+	}
+
+	summary := got[5]
+	if summary.Status != nil || summary.Assignee != nil || summary.Priority != nil || summary.Tags != nil {
+		t.Errorf("got typed diff for the summary change: %+v", summary)
+	}
+	if string(summary.From) != `"Old"` || string(summary.To) != `"New"` {
+		t.Errorf("got raw values %s and %s, want the summaries", summary.From, summary.To)
+	}
+	for i, change := range got[:5] {
+		if change.Field.ID == "" || len(change.From) == 0 || len(change.To) == 0 {
+			t.Errorf("change %d lost the field or raw values: %+v", i, change)
+		}
+	}
+}
+
+func TestFieldChangeJSONInvalidDiff(t *testing.T) {
+	var got FieldChange
+	err := json.Unmarshal([]byte(`{"field": {"id": "status"}, "from": "open", "to": null}`), &got)
+	if err == nil {
+		t.Errorf("got %+v, want error for the status given as a string", got)
+	}
+}
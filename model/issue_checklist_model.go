@@ -0,0 +1,73 @@
+// Package model contains an entities for exchanging information with the Yandex Tracker API
+package model
+
+// ChecklistItemRequest describes request to add or edit checklist item of the issue.
+type ChecklistItemRequest struct {
+	// Mandatory when adding
+
+	// Text of the item.
+	Text string `json:"text,omitempty"`
+
+	// Optional
+
+	// Whether the item is done. Nil leaves the item unchanged when editing.
+	Checked *bool `json:"checked,omitempty"`
+	// ID or login of the user responsible for the item.
+	Assignee string `json:"assignee,omitempty"`
+	// Deadline of the item.
+	Deadline *ChecklistDeadline `json:"deadline,omitempty"`
+}
+
+// ChecklistItemMoveRequest describes request to move checklist item of the issue.
+type ChecklistItemMoveRequest struct {
+	// ID of the item before which the moved one is placed.
+	Before string `json:"before"`
+}
+
+// ChecklistDeadline describes deadline of checklist item
+type ChecklistDeadline struct {
+	// Date and time of the deadline.
+	Date string `json:"date"`
+	// Type of the deadline, see DeadlineTypeDate.
+	DeadlineType string `json:"deadlineType"`
+	// Whether the deadline has passed (response only).
+	IsExceeded bool `json:"isExceeded,omitempty"`
+}
+
+// ChecklistAssignee describes user responsible for checklist item
+type ChecklistAssignee struct {
+	// User ID.
+	ID int `json:"id"`
+	// Displayed user name.
+	Display string `json:"display"`
+	// User ID in Yandex ID.
+	PassportUID int `json:"passportUid"`
+	// User login.
+	Login string `json:"login"`
+	// User first name.
+	FirstName string `json:"firstName"`
+	// User last name.
+	LastName string `json:"lastName"`
+	// User email.
+	Email string `json:"email"`
+	// User ID in Tracker.
+	TrackerUID int `json:"trackerUid"`
+}
+
+// ChecklistItemResponse describes checklist item of the issue.
+type ChecklistItemResponse struct {
+	// Item ID.
+	ID string `json:"id"`
+	// Text of the item.
+	Text string `json:"text"`
+	// HTML markup of the text.
+	TextHTML string `json:"textHtml"`
+	// Whether the item is done.
+	Checked bool `json:"checked"`
+	// Block with information about the user responsible for the item.
+	Assignee *ChecklistAssignee `json:"assignee,omitempty"`
+	// Block with information about the deadline of the item.
+	Deadline *ChecklistDeadline `json:"deadline,omitempty"`
+	// Type of the item.
+	ChecklistItemType string `json:"checklistItemType"`
+}
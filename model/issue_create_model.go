@@ -44,3 +44,8 @@ type IssueCreateRequest struct {
 	// An array of strings containing information about сomponents.
 	Components []string `json:"components,omitempty"`
 }
+
+// UniqueKey returns the Unique field. Requests with the key can be retried safely.
+func (r *IssueCreateRequest) UniqueKey() string {
+	return r.Unique
+}
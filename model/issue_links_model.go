@@ -0,0 +1,62 @@
+// Package model contains an entities for exchanging information with the Yandex Tracker API
+package model
+
+// LinkRelationship is a type of the link between issues, see Relationship* constants
+type LinkRelationship string
+
+// LinkDirection is a direction of the link relative to the issue it was requested for
+type LinkDirection string
+
+// IssueLinkRequest describes request to link the issue with another one.
+type IssueLinkRequest struct {
+	// Mandatory
+
+	// Type of the link between the issues.
+	Relationship LinkRelationship `json:"relationship"`
+	// ID or key of the linked issue.
+	Issue string `json:"issue"`
+}
+
+// IssueLinkType describes type of the link
+type IssueLinkType struct {
+	// Link to the link type.
+	Self string `json:"self"`
+	// Link type ID.
+	ID string `json:"id"`
+	// Name of the link as seen from the linked issue.
+	Inward string `json:"inward"`
+	// Name of the link as seen from the issue the link was requested for.
+	Outward string `json:"outward"`
+}
+
+// LinkedIssue describes the issue on the other end of the link
+type LinkedIssue struct {
+	ObjectBaseResponse
+	Key string `json:"key"`
+}
+
+// IssueLinkResponse describes link between the issue and another one.
+type IssueLinkResponse struct {
+	// Link to the link.
+	Self string `json:"self"`
+	// Link ID.
+	ID int `json:"id"`
+	// Block with information about the link type.
+	Type IssueLinkType `json:"type"`
+	// Direction of the link: inward or outward.
+	Direction LinkDirection `json:"direction"`
+	// Block with information about the linked issue.
+	Object LinkedIssue `json:"object"`
+	// Block with information about the user who created the link.
+	CreatedBy CreatedBy `json:"createdBy"`
+	// Block with information about the user who last changed the link.
+	UpdatedBy UpdatedBy `json:"updatedBy"`
+	// Date and time the link was created.
+	CreatedAt string `json:"createdAt"`
+	// Date and time the link was updated.
+	UpdatedAt string `json:"updatedAt"`
+	// Block with information about the assignee of the linked issue.
+	Assignee IssueAssignee `json:"assignee"`
+	// Block with information about the status of the linked issue.
+	Status IssueStatus `json:"status"`
+}
@@ -0,0 +1,24 @@
+// Package model contains an entities for exchanging information with the Yandex Tracker API
+package model
+
+// IssueMoveRequest describes request to move the issue to another queue.
+// Queue, MoveAllFields and InitialStatus are sent as query parameters, the other fields override issue fields.
+type IssueMoveRequest struct {
+	// Mandatory
+
+	// Key of the target queue.
+	Queue string `json:"-"`
+
+	// Optional
+
+	// Move versions, components and projects as well. They must exist in the target queue.
+	MoveAllFields bool `json:"-"`
+	// Reset the issue to the initial status of the target queue workflow.
+	InitialStatus bool `json:"-"`
+	// Issue type in the target queue.
+	Type ObjectBaseRequest `json:"type,omitzero"`
+	// Object with priority information.
+	Priority ObjectBaseRequest `json:"priority,omitzero"`
+	// Names or IDs of the components of the target queue.
+	Components []string `json:"components,omitempty"`
+}
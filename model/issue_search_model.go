@@ -87,6 +87,8 @@ type IssueResponse struct {
 	Tags []string `json:"tags"`
 	// An array of objects containing information about сomponents.
 	Components []IssueComponent `json:"components"`
+	// An array of objects describing checklist items.
+	ChecklistItems []ChecklistItemResponse `json:"checklistItems"`
 }
 
 // IssueComponent describes component field in issue.
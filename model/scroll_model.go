@@ -0,0 +1,24 @@
+// Package model contains an entities for exchanging information with the Yandex Tracker API
+package model
+
+// ScrollRequest describes scroll search parameters used to get more than 10000 issues
+type ScrollRequest struct {
+	// Scroll type:
+	// sorted — the Order of the search request is respected;
+	// unsorted — issues are returned in arbitrary order.
+	ScrollType string
+	// Maximum number of issues per response, up to 1000.
+	PerScroll int
+	// Lifetime of the scroll context in milliseconds.
+	ScrollTTLMillis int
+}
+
+// ScrollResponse describes scroll response headers
+type ScrollResponse struct {
+	// Scroll identifier passed with the next request.
+	ScrollID string
+	// Token required to clear the scroll.
+	ScrollToken string
+	// Total number of issues
+	TotalCount int
+}
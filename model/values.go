@@ -35,6 +35,56 @@ const (
 	ExpandNone        = ""
 )
 
+// Scroll types
+const (
+	ScrollTypeSorted   = "sorted"
+	ScrollTypeUnsorted = "unsorted"
+)
+
+// Issue link relationships
+const (
+	RelationshipRelates         LinkRelationship = "relates"
+	RelationshipIsDependentBy   LinkRelationship = "is dependent by"
+	RelationshipDependsOn       LinkRelationship = "depends on"
+	RelationshipIsSubtaskFor    LinkRelationship = "is subtask for"
+	RelationshipIsParentTaskFor LinkRelationship = "is parent task for"
+	RelationshipDuplicates      LinkRelationship = "duplicates"
+	RelationshipIsDuplicatedBy  LinkRelationship = "is duplicated by"
+	RelationshipIsEpicOf        LinkRelationship = "is epic of"
+	RelationshipHasEpic         LinkRelationship = "has epic"
+)
+
+// Issue link directions
+const (
+	LinkDirectionInward  LinkDirection = "inward"
+	LinkDirectionOutward LinkDirection = "outward"
+)
+
+// Checklist deadline types
+const (
+	DeadlineTypeDate = "date"
+)
+
+// Changelog entry types
+const (
+	ChangelogTypeIssueCreated         = "IssueCreated"
+	ChangelogTypeIssueUpdated         = "IssueUpdated"
+	ChangelogTypeIssueWorkflow        = "IssueWorkflow"
+	ChangelogTypeIssueMoved           = "IssueMoved"
+	ChangelogTypeIssueCommentAdded    = "IssueCommentAdded"
+	ChangelogTypeIssueLinked          = "IssueLinked"
+	ChangelogTypeIssueUnlinked        = "IssueUnlinked"
+	ChangelogTypeIssueAttachmentAdded = "IssueAttachmentAdded"
+)
+
+// Changelog field IDs with typed diffs
+const (
+	ChangelogFieldStatus   = "status"
+	ChangelogFieldAssignee = "assignee"
+	ChangelogFieldPriority = "priority"
+	ChangelogFieldTags     = "tags"
+)
+
 // Issue Transition IDs
 const (
 	InProgrssTransitionID    = "start_progress"
@@ -0,0 +1,245 @@
+// Package model contains an entities for exchanging information with the Yandex Tracker API
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// WorklogRequest describes request to add a record of time spent on the issue.
+type WorklogRequest struct {
+	// Mandatory
+
+	// Date and time the work started.
+	Start time.Time
+	// Time spent on the work.
+	Duration time.Duration
+
+	// Optional
+
+	// Comment on the work.
+	Comment string
+}
+
+type worklogRequestJSON struct {
+	Start    string `json:"start"`
+	Duration string `json:"duration"`
+	Comment  string `json:"comment,omitempty"`
+}
+
+// MarshalJSON encodes the request with date and time in TimeLayout and ISO 8601 duration
+func (r WorklogRequest) MarshalJSON() ([]byte, error) {
+	return json.Marshal(worklogRequestJSON{
+		Start:    FormatTime(r.Start),
+		Duration: FormatDuration(r.Duration),
+		Comment:  r.Comment,
+	})
+}
+
+// UnmarshalJSON decodes the request with date and time in TimeLayout and ISO 8601 duration
+func (r *WorklogRequest) UnmarshalJSON(data []byte) error {
+	var raw worklogRequestJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	start, err := ParseTime(raw.Start)
+	if err != nil {
+		return err
+	}
+	duration, err := ParseDuration(raw.Duration)
+	if err != nil {
+		return err
+	}
+	*r = WorklogRequest{Start: start, Duration: duration, Comment: raw.Comment}
+	return nil
+}
+
+// WorklogUpdateRequest describes request to update the record of time spent on the issue.
+// Zero values are left unchanged.
+type WorklogUpdateRequest struct {
+	// Time spent on the work.
+	Duration time.Duration
+	// Comment on the work.
+	Comment string
+}
+
+type worklogUpdateRequestJSON struct {
+	Duration string `json:"duration,omitempty"`
+	Comment  string `json:"comment,omitempty"`
+}
+
+// MarshalJSON encodes the request with ISO 8601 duration
+func (r WorklogUpdateRequest) MarshalJSON() ([]byte, error) {
+	raw := worklogUpdateRequestJSON{Comment: r.Comment}
+	if r.Duration > 0 {
+		raw.Duration = FormatDuration(r.Duration)
+	}
+	return json.Marshal(raw)
+}
+
+// UnmarshalJSON decodes the request with ISO 8601 duration
+func (r *WorklogUpdateRequest) UnmarshalJSON(data []byte) error {
+	var raw worklogUpdateRequestJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	*r = WorklogUpdateRequest{Comment: raw.Comment}
+	if raw.Duration != "" {
+		duration, err := ParseDuration(raw.Duration)
+		if err != nil {
+			return err
+		}
+		r.Duration = duration
+	}
+	return nil
+}
+
+// WorklogIssue describes the issue the time was spent on
+type WorklogIssue struct {
+	ObjectBaseResponse
+	Key string `json:"key"`
+}
+
+// WorklogResponse describes record of time spent on the issue.
+type WorklogResponse struct {
+	// Link to the record.
+	Self string
+	// Record ID.
+	ID int
+	// Record version. Each change to the record increments the version number.
+	Version int
+	// Block with information about the issue.
+	Issue WorklogIssue
+	// Comment on the work.
+	Comment string
+	// Block with information about the user who added the record.
+	CreatedBy CreatedBy
+	// Block with information about the user who last changed the record.
+	UpdatedBy UpdatedBy
+	// Date and time the record was created.
+	CreatedAt time.Time
+	// Date and time the record was updated.
+	UpdatedAt time.Time
+	// Date and time the work started.
+	Start time.Time
+	// Time spent on the work.
+	Duration time.Duration
+}
+
+type worklogResponseJSON struct {
+	Self      string       `json:"self"`
+	ID        int          `json:"id"`
+	Version   int          `json:"version"`
+	Issue     WorklogIssue `json:"issue"`
+	Comment   string       `json:"comment"`
+	CreatedBy CreatedBy    `json:"createdBy"`
+	UpdatedBy UpdatedBy    `json:"updatedBy"`
+	CreatedAt string       `json:"createdAt"`
+	UpdatedAt string       `json:"updatedAt"`
+	Start     string       `json:"start"`
+	Duration  string       `json:"duration"`
+}
+
+// MarshalJSON encodes the record with date and time in TimeLayout and ISO 8601 duration
+func (r WorklogResponse) MarshalJSON() ([]byte, error) {
+	return json.Marshal(worklogResponseJSON{
+		Self:      r.Self,
+		ID:        r.ID,
+		Version:   r.Version,
+		Issue:     r.Issue,
+		Comment:   r.Comment,
+		CreatedBy: r.CreatedBy,
+		UpdatedBy: r.UpdatedBy,
+		CreatedAt: FormatTime(r.CreatedAt),
+		UpdatedAt: FormatTime(r.UpdatedAt),
+		Start:     FormatTime(r.Start),
+		Duration:  FormatDuration(r.Duration),
+	})
+}
+
+// UnmarshalJSON decodes the record with date and time in TimeLayout and ISO 8601 duration
+func (r *WorklogResponse) UnmarshalJSON(data []byte) error {
+	var raw worklogResponseJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	result := WorklogResponse{
+		Self:      raw.Self,
+		ID:        raw.ID,
+		Version:   raw.Version,
+		Issue:     raw.Issue,
+		Comment:   raw.Comment,
+		CreatedBy: raw.CreatedBy,
+		UpdatedBy: raw.UpdatedBy,
+	}
+	var err error
+	for _, field := range []struct {
+		value string
+		dest  *time.Time
+	}{
+		{raw.CreatedAt, &result.CreatedAt},
+		{raw.UpdatedAt, &result.UpdatedAt},
+		{raw.Start, &result.Start},
+	} {
+		if *field.dest, err = ParseTime(field.value); err != nil {
+			return err
+		}
+	}
+	if raw.Duration != "" {
+		if result.Duration, err = ParseDuration(raw.Duration); err != nil {
+			return err
+		}
+	}
+	*r = result
+	return nil
+}
+
+// WorklogSearchRequest describes request to find records of time spent by author and creation date.
+type WorklogSearchRequest struct {
+	// Optional
+
+	// Login or ID of the user who added the records.
+	CreatedBy string
+	// Records created at or after this time. Zero value is not limited.
+	From time.Time
+	// Records created at or before this time. Zero value is not limited.
+	To time.Time
+}
+
+type worklogSearchRequestJSON struct {
+	CreatedBy string         `json:"createdBy,omitempty"`
+	CreatedAt *timeRangeJSON `json:"createdAt,omitempty"`
+}
+
+type timeRangeJSON struct {
+	From string `json:"from,omitempty"`
+	To   string `json:"to,omitempty"`
+}
+
+// MarshalJSON encodes the request with the creation date range in TimeLayout
+func (r WorklogSearchRequest) MarshalJSON() ([]byte, error) {
+	raw := worklogSearchRequestJSON{CreatedBy: r.CreatedBy}
+	if !r.From.IsZero() || !r.To.IsZero() {
+		raw.CreatedAt = &timeRangeJSON{From: FormatTime(r.From), To: FormatTime(r.To)}
+	}
+	return json.Marshal(raw)
+}
+
+// UnmarshalJSON decodes the request with the creation date range in TimeLayout
+func (r *WorklogSearchRequest) UnmarshalJSON(data []byte) error {
+	var raw worklogSearchRequestJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	*r = WorklogSearchRequest{CreatedBy: raw.CreatedBy}
+	if raw.CreatedAt != nil {
+		var err error
+		if r.From, err = ParseTime(raw.CreatedAt.From); err != nil {
+			return err
+		}
+		if r.To, err = ParseTime(raw.CreatedAt.To); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package model
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestWorklogResponseJSON(t *testing.T) {
+	data := []byte(`{
+		"self": "https://api.tracker.yandex.net/v3/issues/TEST-1/worklog/7",
+		"id": 7,
+		"version": 2,
+		"issue": {"self": "https://api.tracker.yandex.net/v3/issues/TEST-1", "id": "abc", "key": "TEST-1", "display": "Issue"},
+		"comment": "review",
+		"createdBy": {"self": "https://api.tracker.yandex.net/v3/users/1", "id": "1", "display": "Test User"},
+		"updatedBy": {"self": "https://api.tracker.yandex.net/v3/users/1", "id": "1", "display": "Test User"},
+		"createdAt": "2025-03-01T12:00:00.000+0000",
+		"updatedAt": "2025-03-01T13:00:00.000+0300",
+		"start": "2025-03-01T09:00:00.000+0000",
+		"duration": "P1DT30M"
+	}`)
+
+	var got WorklogResponse
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("failed to unmarshal: %v", err)
+	}
+	if got.ID != 7 || got.Issue.Key != "TEST-1" || got.Comment != "review" || got.CreatedBy.ID != "1" {
+		t.Errorf("got %+v", got)
+	}
+	if want := WorkDay + 30*time.Minute; got.Duration != want {
+		t.Errorf("got duration %v, want %v", got.Duration, want)
+	}
+	if want := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC); !got.UpdatedAt.Equal(want) {
+		t.Errorf("got updatedAt %v, want %v", got.UpdatedAt, want)
+	}
+
+	encoded, err := json.Marshal(got)
+	if err != nil {
+		t.Fatalf("failed to marshal: %v", err)
+	}
+	var fields map[string]any
+	if err := json.Unmarshal(encoded, &fields); err != nil {
+		t.Fatalf("failed to unmarshal encoded record: %v", err)
+	}
+	if fields["duration"] != "PT8H30M" || fields["start"] != "2025-03-01T09:00:00.000+0000" {
+		t.Errorf("got duration %v and start %v", fields["duration"], fields["start"])
+	}
+
+	var decoded WorklogResponse
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		t.Fatalf("failed to unmarshal encoded record: %v", err)
+	}
+	if !reflect.DeepEqual(decoded, got) {
+		t.Errorf("got %+v after round trip, want %+v", decoded, got)
+	}
+}
+
+func TestWorklogResponseInvalidDuration(t *testing.T) {
+	var got WorklogResponse
+	if err := json.Unmarshal([]byte(`{"id": 1, "duration": "P1M"}`), &got); err == nil {
+		t.Error("got no error for duration in months")
+	}
+}
+
+func TestWorklogUpdateRequestJSON(t *testing.T) {
+	tests := []struct {
+		req  WorklogUpdateRequest
+		want string
+	}{
+		{WorklogUpdateRequest{Duration: 90 * time.Minute, Comment: "done"}, `{"duration":"PT1H30M","comment":"done"}`},
+		{WorklogUpdateRequest{Comment: "done"}, `{"comment":"done"}`},
+		{WorklogUpdateRequest{}, `{}`},
+	}
+	for _, tt := range tests {
+		encoded, err := json.Marshal(tt.req)
+		if err != nil {
+			t.Fatalf("failed to marshal: %v", err)
+		}
+		if string(encoded) != tt.want {
+			t.Errorf("got %s, want %s", encoded, tt.want)
+		}
+		var decoded WorklogUpdateRequest
+		if err := json.Unmarshal(encoded, &decoded); err != nil {
+			t.Fatalf("failed to unmarshal: %v", err)
+		}
+		if decoded != tt.req {
+			t.Errorf("got %+v after round trip, want %+v", decoded, tt.req)
+		}
+	}
+}
@@ -0,0 +1,264 @@
+// Package client provides methods, values and urls for interacting with Yandex Tracker
+package client
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Option configures Client created with NewWithOptions
+type Option func(*options) error
+
+// options accumulates Client configuration
+type options struct {
+	tokenSource TokenSource
+	orgID       string
+	cloudOrgID  string
+	lang        string
+	baseURL     string
+	apiVersion  string
+	userAgent   string
+	httpClient  *http.Client
+	timeout     time.Duration
+	retryPolicy *RetryPolicy
+	rateLimiter *RateLimiter
+	middlewares []Middleware
+
+	tracerProvider trace.TracerProvider
+	metrics        MetricsCollector
+	logger         *slog.Logger
+	bodyLogLimit   int
+	cache          *CacheConfig
+}
+
+func defaultOptions() *options {
+	return &options{
+		lang:       defaultLang,
+		baseURL:    defaultBaseURL,
+		apiVersion: defaultAPIVersion,
+	}
+}
+
+// apiURL joins base URL and API version
+func (o *options) apiURL() string {
+	return strings.TrimRight(o.baseURL, "/") + "/" + o.apiVersion + "/"
+}
+
+func (o *options) validate() error {
+	if o.tokenSource == nil {
+		return errors.New("token or token source is required")
+	}
+	if o.orgID != "" && o.cloudOrgID != "" {
+		return errors.New("only one of org ID and cloud org ID must be set")
+	}
+	if o.orgID == "" && o.cloudOrgID == "" {
+		return errors.New("org ID or cloud org ID is required")
+	}
+	return nil
+}
+
+// WithOAuthToken sets OAuth token used for authorization
+func WithOAuthToken(token string) Option {
+	return func(o *options) error {
+		if token == "" {
+			return errors.New("empty OAuth token")
+		}
+		o.tokenSource = StaticOAuthToken(token)
+		return nil
+	}
+}
+
+// WithIAMToken sets static IAM token used for authorization with Bearer scheme
+func WithIAMToken(token string) Option {
+	return func(o *options) error {
+		if token == "" {
+			return errors.New("empty IAM token")
+		}
+		o.tokenSource = StaticIAMToken(token)
+		return nil
+	}
+}
+
+// WithTokenSource sets source of tokens, e.g. ServiceAccountTokenSource
+func WithTokenSource(tokenSource TokenSource) Option {
+	return func(o *options) error {
+		if tokenSource == nil {
+			return errors.New("nil token source")
+		}
+		o.tokenSource = tokenSource
+		return nil
+	}
+}
+
+// WithOrgID sets X-Org-ID header for Yandex 360 for Business organizations
+func WithOrgID(orgID string) Option {
+	return func(o *options) error {
+		if orgID == "" {
+			return errors.New("empty org ID")
+		}
+		o.orgID = orgID
+		return nil
+	}
+}
+
+// WithCloudOrgID sets X-Cloud-Org-ID header for Yandex Cloud organizations
+func WithCloudOrgID(cloudOrgID string) Option {
+	return func(o *options) error {
+		if cloudOrgID == "" {
+			return errors.New("empty cloud org ID")
+		}
+		o.cloudOrgID = cloudOrgID
+		return nil
+	}
+}
+
+// WithLanguage sets default Accept-Language header: "ru" or "en"
+func WithLanguage(lang string) Option {
+	return func(o *options) error {
+		if lang != "ru" && lang != "en" {
+			return fmt.Errorf("unsupported language: %q", lang)
+		}
+		o.lang = lang
+		return nil
+	}
+}
+
+// WithBaseURL sets API root without version, e.g. http://localhost:8080 for a local stand-in
+func WithBaseURL(baseURL string) Option {
+	return func(o *options) error {
+		u, err := url.Parse(baseURL)
+		if err != nil {
+			return fmt.Errorf("invalid base URL: %w", err)
+		}
+		if u.Scheme != "http" && u.Scheme != "https" || u.Host == "" {
+			return fmt.Errorf("invalid base URL: %q", baseURL)
+		}
+		o.baseURL = baseURL
+		return nil
+	}
+}
+
+// WithAPIVersion sets API version: "v2" or "v3"
+func WithAPIVersion(version string) Option {
+	return func(o *options) error {
+		if version != "v2" && version != "v3" {
+			return fmt.Errorf("unsupported API version: %q", version)
+		}
+		o.apiVersion = version
+		return nil
+	}
+}
+
+// WithHTTPClient sets custom HTTP client, e.g. with own transport
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(o *options) error {
+		if httpClient == nil {
+			return errors.New("nil HTTP client")
+		}
+		o.httpClient = httpClient
+		return nil
+	}
+}
+
+// WithTimeout sets timeout of a single HTTP request
+func WithTimeout(timeout time.Duration) Option {
+	return func(o *options) error {
+		if timeout < 0 {
+			return fmt.Errorf("negative timeout: %v", timeout)
+		}
+		o.timeout = timeout
+		return nil
+	}
+}
+
+// WithUserAgent sets User-Agent header
+func WithUserAgent(userAgent string) Option {
+	return func(o *options) error {
+		o.userAgent = userAgent
+		return nil
+	}
+}
+
+// WithRetryPolicy sets the policy of retrying failed requests
+func WithRetryPolicy(policy *RetryPolicy) Option {
+	return func(o *options) error {
+		o.retryPolicy = policy
+		return nil
+	}
+}
+
+// WithRateLimiter sets the limiter every request waits on,
+// including the parallel ones sent by FetchPagesConcurrently and GetIssues
+func WithRateLimiter(limiter *RateLimiter) Option {
+	return func(o *options) error {
+		o.rateLimiter = limiter
+		return nil
+	}
+}
+
+// WithMiddleware appends middlewares to the chain, see Client.Use
+func WithMiddleware(middlewares ...Middleware) Option {
+	return func(o *options) error {
+		for _, middleware := range middlewares {
+			if middleware == nil {
+				return errors.New("nil middleware")
+			}
+		}
+		o.middlewares = append(o.middlewares, middlewares...)
+		return nil
+	}
+}
+
+// WithTracerProvider enables OpenTelemetry tracing: a span per request named after
+// the resource URL template and a parent span per *All helper call
+func WithTracerProvider(tracerProvider trace.TracerProvider) Option {
+	return func(o *options) error {
+		o.tracerProvider = tracerProvider
+		return nil
+	}
+}
+
+// WithMetricsCollector sets the receiver of request metrics, e.g. prommetrics.Collector
+func WithMetricsCollector(metrics MetricsCollector) Option {
+	return func(o *options) error {
+		o.metrics = metrics
+		return nil
+	}
+}
+
+// WithLogger sets structured logger of requests, see Client.SetLogger
+func WithLogger(logger *slog.Logger) Option {
+	return func(o *options) error {
+		o.logger = logger
+		return nil
+	}
+}
+
+// WithBodyLogLimit enables logging of bodies truncated to limit bytes, see Client.SetBodyLogLimit
+func WithBodyLogLimit(limit int) Option {
+	return func(o *options) error {
+		if limit < 0 {
+			return fmt.Errorf("negative body log limit: %d", limit)
+		}
+		o.bodyLogLimit = limit
+		return nil
+	}
+}
+
+// WithCache enables caching of priorities, users and components, see Client.SetCache
+func WithCache(config CacheConfig) Option {
+	return func(o *options) error {
+		if config.PrioritiesTTL < 0 || config.UsersTTL < 0 || config.ComponentsTTL < 0 {
+			return errors.New("negative cache TTL")
+		}
+		o.cache = &config
+		return nil
+	}
+}
@@ -0,0 +1,86 @@
+package client
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestNewWithOptionsValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    []Option
+		wantErr bool
+	}{
+		{"org ID", []Option{WithOAuthToken("token"), WithOrgID("1")}, false},
+		{"cloud org ID", []Option{WithIAMToken("token"), WithCloudOrgID("bpf")}, false},
+		{"both org IDs", []Option{WithOAuthToken("token"), WithOrgID("1"), WithCloudOrgID("bpf")}, true},
+		{"no org ID", []Option{WithOAuthToken("token")}, true},
+		{"empty org ID", []Option{WithOAuthToken("token"), WithOrgID("")}, true},
+		{"empty cloud org ID", []Option{WithOAuthToken("token"), WithCloudOrgID("")}, true},
+		{"no token", []Option{WithOrgID("1")}, true},
+		{"base URL", []Option{WithOAuthToken("token"), WithOrgID("1"), WithBaseURL("http://localhost:8080")}, false},
+		{"base URL without scheme", []Option{WithOAuthToken("token"), WithOrgID("1"), WithBaseURL("localhost:8080")}, true},
+		{"base URL with other scheme", []Option{WithOAuthToken("token"), WithOrgID("1"), WithBaseURL("ftp://tracker")}, true},
+		{"unparsable base URL", []Option{WithOAuthToken("token"), WithOrgID("1"), WithBaseURL("http://[::1")}, true},
+		{"language", []Option{WithOAuthToken("token"), WithOrgID("1"), WithLanguage("en")}, false},
+		{"unsupported language", []Option{WithOAuthToken("token"), WithOrgID("1"), WithLanguage("de")}, true},
+		{"unsupported API version", []Option{WithOAuthToken("token"), WithOrgID("1"), WithAPIVersion("v1")}, true},
+		{"nil HTTP client", []Option{WithOAuthToken("token"), WithOrgID("1"), WithHTTPClient(nil)}, true},
+		{"negative cache TTL", []Option{WithOAuthToken("token"), WithOrgID("1"), WithCache(CacheConfig{UsersTTL: -time.Second})}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewWithOptions(tt.opts...)
+			if tt.wantErr {
+				if err == nil {
+					t.Error("got no error")
+				}
+				return
+			}
+			if err != nil || c == nil {
+				t.Fatalf("got error %v", err)
+			}
+		})
+	}
+}
+
+func TestNewWithOptionsHeaders(t *testing.T) {
+	c, err := NewWithOptions(WithOAuthToken("token"), WithCloudOrgID("bpf"), WithLanguage("en"), WithBaseURL("http://localhost:8080/"), WithAPIVersion("v3"))
+	if err != nil {
+		t.Fatalf("NewWithOptions failed: %v", err)
+	}
+	assertHeaders(t, c.restyClient.Header(), map[string]string{"X-Cloud-Org-ID": "bpf", "X-Org-ID": "", "Accept-Language": "en"})
+	if got := c.restyClient.BaseURL(); got != "http://localhost:8080/v3" {
+		t.Errorf("got base URL %q", got)
+	}
+}
+
+func TestNewHeaders(t *testing.T) {
+	tests := []struct {
+		name                        string
+		cloudOrgID, orgID, language string
+		want                        map[string]string
+	}{
+		{"english", "", "1", "en", map[string]string{"X-Org-ID": "1", "X-Cloud-Org-ID": "", "Accept-Language": "en"}},
+		{"russian", "bpf", "", "ru", map[string]string{"X-Org-ID": "", "X-Cloud-Org-ID": "bpf", "Accept-Language": "ru"}},
+		{"unsupported language", "bpf", "", "de", map[string]string{"Accept-Language": defaultLang}},
+		{"org ID preferred", "bpf", "1", "en", map[string]string{"X-Org-ID": "1", "X-Cloud-Org-ID": ""}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := New("token", tt.cloudOrgID, tt.orgID, tt.language)
+			assertHeaders(t, c.restyClient.Header(), tt.want)
+		})
+	}
+}
+
+// assertHeaders checks the header values, empty value means the header is absent
+func assertHeaders(t *testing.T, header http.Header, want map[string]string) {
+	t.Helper()
+	for name, value := range want {
+		if got := header.Get(name); got != value {
+			t.Errorf("got %s %q, want %q", name, got, value)
+		}
+	}
+}
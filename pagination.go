@@ -0,0 +1,218 @@
+// Package client provides methods, values and urls for interacting with Yandex Tracker
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	model "github.com/IndianMax03/yandex-tracker-go-client/model"
+)
+
+// PageError describes failure of a single page requested by *All helpers
+type PageError struct {
+	// Number of the failed page (page-number pagination).
+	Page int
+	// ID after which the failed page begins (fromID pagination).
+	FromID int
+	// Cause of the failure.
+	Err error
+}
+
+// Error implements error interface
+func (e *PageError) Error() string {
+	if e.FromID > 0 {
+		return fmt.Sprintf("page after ID %d: %v", e.FromID, e.Err)
+	}
+	return fmt.Sprintf("page %d: %v", e.Page, e.Err)
+}
+
+// Unwrap returns the cause of the failure
+func (e *PageError) Unwrap() error {
+	return e.Err
+}
+
+// PartialResultError is returned by *All helpers together with the objects collected
+// from the pages that succeeded
+type PartialResultError struct {
+	// Failed pages in the order they were requested.
+	Errors []*PageError
+}
+
+// Error implements error interface
+func (e *PartialResultError) Error() string {
+	messages := make([]string, 0, len(e.Errors))
+	for _, pageErr := range e.Errors {
+		messages = append(messages, pageErr.Error())
+	}
+	return fmt.Sprintf("%d page(s) failed: %s", len(e.Errors), strings.Join(messages, "; "))
+}
+
+// Unwrap returns page errors, so errors.Is and errors.As look through them
+func (e *PartialResultError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Errors))
+	for _, pageErr := range e.Errors {
+		errs = append(errs, pageErr)
+	}
+	return errs
+}
+
+// PaginationOption configures *All helpers
+type PaginationOption func(*paginationOptions)
+
+type paginationOptions struct {
+	continueOnError bool
+	concurrency     int
+}
+
+func newPaginationOptions(opts []PaginationOption) *paginationOptions {
+	o := &paginationOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// ContinueOnPageError makes *All helpers skip failed pages and report them in PartialResultError
+// instead of stopping at the first failure. It has no effect on fromID pagination (comments),
+// where the next page cannot be requested without the previous one.
+func ContinueOnPageError() PaginationOption {
+	return func(o *paginationOptions) {
+		o.continueOnError = true
+	}
+}
+
+// FetchPagesConcurrently makes *All helpers request pages after the first one with up to n requests in parallel.
+// Pages are reassembled in order. Without ContinueOnPageError the first failure cancels the requests in flight
+// and only objects of the pages completed without a gap from the first page are returned.
+// It has no effect on fromID pagination (comments).
+func FetchPagesConcurrently(n int) PaginationOption {
+	return func(o *paginationOptions) {
+		o.concurrency = n
+	}
+}
+
+// collectPages requests all pages by number. The first page error is returned as is,
+// later failures are reported with PartialResultError alongside the collected objects.
+func collectPages[T any](ctx context.Context, c *Client, name string, fetch pageFetcher[T], opts []PaginationOption) (result []T, err error) {
+	ctx, span := c.startPaginationSpan(ctx, name)
+	defer func() { endPaginationSpan(span, len(result), err) }()
+
+	o := newPaginationOptions(opts)
+	pageReq := model.PageRequest{
+		Page:    1,
+		PerPage: defaultPerPage,
+	}
+	result, pag, err := fetch(ctx, &pageReq)
+	if err != nil {
+		return nil, err
+	}
+	if o.concurrency > 1 && pag.TotalPages > 2 {
+		return collectPagesConcurrently(ctx, fetch, result, pag.TotalPages, o)
+	}
+	var partialErr PartialResultError
+	for page := 2; page <= pag.TotalPages; page++ {
+		pageReq.Page = page
+		resp, _, err := fetch(ctx, &pageReq)
+		if err != nil {
+			partialErr.Errors = append(partialErr.Errors, &PageError{Page: page, Err: err})
+			if !o.continueOnError || ctx.Err() != nil {
+				break
+			}
+			continue
+		}
+		result = append(result, resp...)
+	}
+	if len(partialErr.Errors) != 0 {
+		return result, &partialErr
+	}
+	return result, nil
+}
+
+// collectPagesConcurrently requests pages from 2 to totalPages in parallel and appends them to first in order
+func collectPagesConcurrently[T any](parent context.Context, fetch pageFetcher[T], first []T, totalPages int, o *paginationOptions) ([]T, error) {
+	ctx, cancel := context.WithCancel(parent)
+	defer cancel()
+
+	pages := make([][]T, totalPages+1)
+	errs := make([]error, totalPages+1)
+	semaphore := make(chan struct{}, o.concurrency)
+	var wg sync.WaitGroup
+	for page := 2; page <= totalPages; page++ {
+		select {
+		case semaphore <- struct{}{}:
+		case <-ctx.Done():
+			errs[page] = ctx.Err()
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+			pageReq := model.PageRequest{
+				Page:    page,
+				PerPage: defaultPerPage,
+			}
+			pages[page], _, errs[page] = fetch(ctx, &pageReq)
+			if errs[page] != nil && !o.continueOnError {
+				cancel()
+			}
+		}()
+	}
+	wg.Wait()
+
+	result := first
+	var partialErr PartialResultError
+	for page := 2; page <= totalPages; page++ {
+		if errs[page] == nil {
+			result = append(result, pages[page]...)
+			continue
+		}
+		if o.continueOnError {
+			partialErr.Errors = append(partialErr.Errors, &PageError{Page: page, Err: errs[page]})
+			continue
+		}
+		// pages after the gap are dropped; report the failure that canceled the rest rather than the cancellation
+		failed := page
+		for cause := 2; cause <= totalPages; cause++ {
+			if errs[cause] != nil && (parent.Err() != nil || !errors.Is(errs[cause], context.Canceled)) {
+				failed = cause
+				break
+			}
+		}
+		partialErr.Errors = append(partialErr.Errors, &PageError{Page: failed, Err: errs[failed]})
+		break
+	}
+	if len(partialErr.Errors) != 0 {
+		return result, &partialErr
+	}
+	return result, nil
+}
+
+// collectFromID requests pages one after another starting each one after the last object of the previous page
+func collectFromID[T any](ctx context.Context, c *Client, name string, fetch pageFetcher[T]) (result []T, err error) {
+	ctx, span := c.startPaginationSpan(ctx, name)
+	defer func() { endPaginationSpan(span, len(result), err) }()
+
+	pageReq := model.PageRequest{
+		PerPage: defaultPerPage,
+	}
+	result, pag, err := fetch(ctx, &pageReq)
+	if err != nil {
+		return nil, err
+	}
+	for pag.LastID > 0 && pag.LastID != pageReq.FromID {
+		pageReq.FromID = pag.LastID
+		var resp []T
+		resp, pag, err = fetch(ctx, &pageReq)
+		if err != nil {
+			return result, &PartialResultError{
+				Errors: []*PageError{{FromID: pageReq.FromID, Err: err}},
+			}
+		}
+		result = append(result, resp...)
+	}
+	return result, nil
+}
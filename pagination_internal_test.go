@@ -0,0 +1,75 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	model "github.com/IndianMax03/yandex-tracker-go-client/model"
+)
+
+func TestCollectPagesConcurrently(t *testing.T) {
+	errPage := errors.New("page failed")
+	tests := []struct {
+		name            string
+		continueOnError bool
+		want            []int
+	}{
+		{"stop at the first failure", false, []int{1, 2}},
+		{"continue on page error", true, []int{1, 2, 4, 5}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			secondDone := make(chan struct{})
+			fetch := func(ctx context.Context, pageReq *model.PageRequest) ([]int, *model.PageResponse, error) {
+				switch pageReq.Page {
+				case 2:
+					defer close(secondDone)
+				case 3:
+					<-secondDone
+					return nil, nil, errPage
+				case 4, 5:
+					if !tt.continueOnError {
+						// pages after the failure must be canceled rather than awaited
+						select {
+						case <-ctx.Done():
+							return nil, nil, ctx.Err()
+						case <-time.After(5 * time.Second):
+						}
+					}
+				}
+				return []int{pageReq.Page}, &model.PageResponse{TotalPages: 5}, nil
+			}
+
+			o := &paginationOptions{concurrency: 4, continueOnError: tt.continueOnError}
+			result, err := collectPagesConcurrently(context.Background(), fetch, []int{1}, 5, o)
+			if !slices.Equal(result, tt.want) {
+				t.Errorf("got pages %v, want %v", result, tt.want)
+			}
+			var partialErr *PartialResultError
+			if !errors.As(err, &partialErr) {
+				t.Fatalf("got error %v, want PartialResultError", err)
+			}
+			if len(partialErr.Errors) != 1 || partialErr.Errors[0].Page != 3 || !errors.Is(partialErr.Errors[0], errPage) {
+				t.Errorf("got page errors %v, want the failure of page 3 only", partialErr.Errors)
+			}
+		})
+	}
+}
+
+func TestCollectPagesConcurrentlyWithoutFailures(t *testing.T) {
+	fetch := func(ctx context.Context, pageReq *model.PageRequest) ([]int, *model.PageResponse, error) {
+		// later pages complete first to check reassembly order
+		time.Sleep(time.Duration(10-pageReq.Page) * time.Millisecond)
+		return []int{pageReq.Page}, &model.PageResponse{TotalPages: 8}, nil
+	}
+	result, err := collectPagesConcurrently(context.Background(), fetch, []int{1}, 8, &paginationOptions{concurrency: 3})
+	if err != nil {
+		t.Fatalf("got error %v", err)
+	}
+	if want := []int{1, 2, 3, 4, 5, 6, 7, 8}; !slices.Equal(result, want) {
+		t.Errorf("got pages %v, want %v", result, want)
+	}
+}
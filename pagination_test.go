@@ -0,0 +1,133 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	client "github.com/IndianMax03/yandex-tracker-go-client"
+	"github.com/IndianMax03/yandex-tracker-go-client/model"
+	"github.com/IndianMax03/yandex-tracker-go-client/trackertest"
+	"resty.dev/v3"
+)
+
+// failRequest returns middleware injecting a single 403 into the server right before the matching request is sent
+func failRequest(srv *trackertest.Server, pathPrefix string, match func(req *client.Request) bool) client.Middleware {
+	return func(next client.Handler) client.Handler {
+		return func(ctx context.Context, req *client.Request) (*resty.Response, error) {
+			if match(req) {
+				srv.InjectFault(trackertest.Fault{PathPrefix: pathPrefix, StatusCode: http.StatusForbidden, Times: 1})
+			}
+			return next(ctx, req)
+		}
+	}
+}
+
+// failPage fails the search request of the page
+func failPage(srv *trackertest.Server, page string) client.Middleware {
+	return failRequest(srv, "/issues/_search", func(req *client.Request) bool {
+		return req.QueryParams["page"] == page
+	})
+}
+
+func TestSearchAllIssuesPageErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		failedPage string
+		opts       []client.PaginationOption
+		wantCount  int
+		wantPages  []int
+		wantSent   int
+	}{
+		{name: "first page", failedPage: "1", wantCount: 0, wantSent: 1},
+		{name: "middle page", failedPage: "2", wantCount: 50, wantPages: []int{2}, wantSent: 2},
+		{name: "continue on page error", failedPage: "2", opts: []client.PaginationOption{client.ContinueOnPageError()}, wantCount: 70, wantPages: []int{2}, wantSent: 3},
+		{name: "last page", failedPage: "3", wantCount: 100, wantPages: []int{3}, wantSent: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := trackertest.NewServer()
+			t.Cleanup(srv.Close)
+			c, err := srv.Client(client.WithMiddleware(failPage(srv, tt.failedPage)))
+			if err != nil {
+				t.Fatalf("failed to create client: %v", err)
+			}
+			keys := addIssues(t, srv, "TEST", 120)
+
+			issues, err := c.SearchAllIssues(&model.IssueSearchRequest{Queue: "TEST"}, tt.opts...)
+			if len(issues) != tt.wantCount {
+				t.Fatalf("got %d issues, want %d", len(issues), tt.wantCount)
+			}
+			for i, issue := range issues {
+				// the failed page leaves a gap of 50 keys
+				want := keys[i]
+				if tt.failedPage == "2" && i >= 50 {
+					want = keys[i+50]
+				}
+				if issue.Key != want {
+					t.Fatalf("got key %q at %d, want %q", issue.Key, i, want)
+				}
+			}
+			if got := countRequests(srv, http.MethodPost, "/issues/_search"); got != tt.wantSent {
+				t.Errorf("got %d pages requested, want %d", got, tt.wantSent)
+			}
+
+			if !errors.Is(err, client.ErrForbidden) {
+				t.Fatalf("got error %v, want ErrForbidden", err)
+			}
+			var partialErr *client.PartialResultError
+			if tt.wantPages == nil {
+				if errors.As(err, &partialErr) {
+					t.Errorf("got PartialResultError %v for the first page", err)
+				}
+				return
+			}
+			if !errors.As(err, &partialErr) {
+				t.Fatalf("got error %v, want PartialResultError", err)
+			}
+			var pages []int
+			for _, pageErr := range partialErr.Errors {
+				pages = append(pages, pageErr.Page)
+			}
+			if len(pages) != len(tt.wantPages) || pages[0] != tt.wantPages[0] {
+				t.Errorf("got failed pages %v, want %v", pages, tt.wantPages)
+			}
+		})
+	}
+}
+
+func TestGetCommentsAllPageError(t *testing.T) {
+	srv := trackertest.NewServer()
+	t.Cleanup(srv.Close)
+	c, err := srv.Client(client.WithMiddleware(failRequest(srv, "/issues/", func(req *client.Request) bool {
+		return req.Method == http.MethodGet && req.QueryParams["id"] != ""
+	})))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	key := addIssues(t, srv, "TEST", 1)[0]
+	var ids []int
+	for range 60 {
+		comment, err := c.CreateComment(key, &model.CommentRequest{Text: "comment"})
+		if err != nil {
+			t.Fatalf("CreateComment failed: %v", err)
+		}
+		ids = append(ids, comment.ID)
+	}
+
+	comments, err := c.GetCommentsAll(key, "")
+	if len(comments) != 50 || comments[49].ID != ids[49] {
+		t.Fatalf("got %d comments, want the first page of 50", len(comments))
+	}
+	var partialErr *client.PartialResultError
+	if !errors.As(err, &partialErr) || !errors.Is(err, client.ErrForbidden) {
+		t.Fatalf("got error %v, want PartialResultError with ErrForbidden", err)
+	}
+	if len(partialErr.Errors) != 1 || partialErr.Errors[0].FromID != ids[49] {
+		t.Errorf("got page errors %v, want the page after comment %d", partialErr.Errors, ids[49])
+	}
+	if got := countRequests(srv, http.MethodGet, "/issues/"+key+"/comments"); got != 2 {
+		t.Errorf("got %d pages requested, want 2", got)
+	}
+}
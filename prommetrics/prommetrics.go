@@ -0,0 +1,89 @@
+// Package prommetrics provides Prometheus implementation of client.MetricsCollector.
+//
+// It is a separate module, so the core client does not depend on Prometheus:
+//
+//	go get github.com/IndianMax03/yandex-tracker-go-client/prommetrics
+package prommetrics
+
+import (
+	"strconv"
+	"time"
+
+	client "github.com/IndianMax03/yandex-tracker-go-client"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const namespace = "yandex_tracker_client"
+
+var _ client.MetricsCollector = (*Collector)(nil)
+
+// Collector exports request metrics of Yandex Tracker Client to Prometheus
+type Collector struct {
+	requests      *prometheus.CounterVec
+	duration      *prometheus.HistogramVec
+	inFlight      *prometheus.GaugeVec
+	retries       *prometheus.CounterVec
+	rateLimitWait *prometheus.HistogramVec
+}
+
+// New instantiates Collector and registers its metrics in reg
+func New(reg prometheus.Registerer) (*Collector, error) {
+	labels := []string{"method", "url_template"}
+	c := &Collector{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "requests_total",
+			Help:      "Number of requests sent to Yandex Tracker by status code. Code 0 means no response was received.",
+		}, append(labels, "code")),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "request_duration_seconds",
+			Help:      "Duration of requests to Yandex Tracker including retries.",
+			Buckets:   prometheus.DefBuckets,
+		}, labels),
+		inFlight: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "requests_in_flight",
+			Help:      "Number of requests to Yandex Tracker being sent.",
+		}, labels),
+		retries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "retries_total",
+			Help:      "Number of retried requests to Yandex Tracker.",
+		}, labels),
+		rateLimitWait: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "rate_limit_wait_seconds",
+			Help:      "Time requests spent waiting on the client-side rate limiter.",
+			Buckets:   []float64{0.001, 0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10},
+		}, labels),
+	}
+	for _, collector := range []prometheus.Collector{c.requests, c.duration, c.inFlight, c.retries, c.rateLimitWait} {
+		if err := reg.Register(collector); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+// RequestStarted increments in-flight gauge
+func (c *Collector) RequestStarted(method, urlTemplate string) {
+	c.inFlight.WithLabelValues(method, urlTemplate).Inc()
+}
+
+// RequestFinished decrements in-flight gauge, counts the request and observes its duration
+func (c *Collector) RequestFinished(method, urlTemplate string, statusCode int, duration time.Duration, _ error) {
+	c.inFlight.WithLabelValues(method, urlTemplate).Dec()
+	c.requests.WithLabelValues(method, urlTemplate, strconv.Itoa(statusCode)).Inc()
+	c.duration.WithLabelValues(method, urlTemplate).Observe(duration.Seconds())
+}
+
+// RequestRetried counts the retry
+func (c *Collector) RequestRetried(method, urlTemplate string) {
+	c.retries.WithLabelValues(method, urlTemplate).Inc()
+}
+
+// RateLimitWaited observes time spent on the rate limiter
+func (c *Collector) RateLimitWaited(method, urlTemplate string, wait time.Duration) {
+	c.rateLimitWait.WithLabelValues(method, urlTemplate).Observe(wait.Seconds())
+}
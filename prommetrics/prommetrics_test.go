@@ -0,0 +1,92 @@
+package prommetrics_test
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	client "github.com/IndianMax03/yandex-tracker-go-client"
+	"github.com/IndianMax03/yandex-tracker-go-client/prommetrics"
+	"github.com/IndianMax03/yandex-tracker-go-client/trackertest"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestCollector(t *testing.T) {
+	reg := prometheus.NewPedanticRegistry()
+	collector, err := prommetrics.New(reg)
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+	srv := trackertest.NewServer()
+	defer srv.Close()
+	c, err := srv.Client(
+		client.WithMetricsCollector(collector),
+		client.WithRateLimiter(client.NewRateLimiter(1000, 10)),
+		client.WithRetryPolicy(&client.RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond, MaxBackoff: time.Second}),
+	)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	srv.InjectFault(trackertest.Fault{Method: http.MethodGet, PathPrefix: "/myself", StatusCode: http.StatusServiceUnavailable, Times: 1})
+	if _, err := c.GetMyself(); err != nil {
+		t.Fatalf("GetMyself failed: %v", err)
+	}
+	if _, err := c.GetIssue("TEST-1", false, false); !errors.Is(err, client.ErrNotFound) {
+		t.Fatalf("got error %v, want ErrNotFound", err)
+	}
+
+	expected := `
+# HELP yandex_tracker_client_requests_total Number of requests sent to Yandex Tracker by status code. Code 0 means no response was received.
+# TYPE yandex_tracker_client_requests_total counter
+yandex_tracker_client_requests_total{code="200",method="GET",url_template="/myself"} 1
+yandex_tracker_client_requests_total{code="404",method="GET",url_template="/issues/{issue_id}"} 1
+# HELP yandex_tracker_client_retries_total Number of retried requests to Yandex Tracker.
+# TYPE yandex_tracker_client_retries_total counter
+yandex_tracker_client_retries_total{method="GET",url_template="/myself"} 1
+# HELP yandex_tracker_client_requests_in_flight Number of requests to Yandex Tracker being sent.
+# TYPE yandex_tracker_client_requests_in_flight gauge
+yandex_tracker_client_requests_in_flight{method="GET",url_template="/issues/{issue_id}"} 0
+yandex_tracker_client_requests_in_flight{method="GET",url_template="/myself"} 0
+`
+	if err := testutil.GatherAndCompare(reg, strings.NewReader(expected),
+		"yandex_tracker_client_requests_total",
+		"yandex_tracker_client_retries_total",
+		"yandex_tracker_client_requests_in_flight",
+	); err != nil {
+		t.Error(err)
+	}
+
+	families, err := reg.Gather()
+	if err != nil {
+		t.Fatalf("Gather failed: %v", err)
+	}
+	observations := map[string]uint64{}
+	for _, family := range families {
+		for _, metric := range family.GetMetric() {
+			if histogram := metric.GetHistogram(); histogram != nil {
+				observations[family.GetName()] += histogram.GetSampleCount()
+			}
+		}
+	}
+	// each attempt waits on the rate limiter, the duration covers the request with retries
+	if got := observations["yandex_tracker_client_rate_limit_wait_seconds"]; got != 3 {
+		t.Errorf("got %d rate limiter waits observed, want 3", got)
+	}
+	if got := observations["yandex_tracker_client_request_duration_seconds"]; got != 2 {
+		t.Errorf("got %d durations observed, want 2", got)
+	}
+}
+
+func TestNewRegistersOnce(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	if _, err := prommetrics.New(reg); err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+	if _, err := prommetrics.New(reg); err == nil {
+		t.Error("got no error registering metrics twice")
+	}
+}
@@ -0,0 +1,70 @@
+// Package client provides methods, values and urls for interacting with Yandex Tracker
+package client
+
+import (
+	"context"
+
+	"golang.org/x/time/rate"
+	"resty.dev/v3"
+)
+
+// RateLimit describes a token bucket budget
+type RateLimit struct {
+	// Number of requests per second allowed on average.
+	RequestsPerSecond float64
+	// Maximum number of requests allowed at once.
+	Burst int
+}
+
+// RateLimiter is a client-side token bucket limiter. It is safe for concurrent use
+// and can be shared by several clients working with the same organization.
+type RateLimiter struct {
+	read  *rate.Limiter
+	write *rate.Limiter
+}
+
+// NewRateLimiter instantiates limiter with a single budget shared by reads and writes
+func NewRateLimiter(requestsPerSecond float64, burst int) *RateLimiter {
+	limiter := newLimiter(RateLimit{RequestsPerSecond: requestsPerSecond, Burst: burst})
+	return &RateLimiter{
+		read:  limiter,
+		write: limiter,
+	}
+}
+
+// NewReadWriteRateLimiter instantiates limiter with separate budgets for reading and modifying requests
+func NewReadWriteRateLimiter(read, write RateLimit) *RateLimiter {
+	return &RateLimiter{
+		read:  newLimiter(read),
+		write: newLimiter(write),
+	}
+}
+
+func newLimiter(limit RateLimit) *rate.Limiter {
+	if limit.RequestsPerSecond <= 0 {
+		return rate.NewLimiter(rate.Inf, 0)
+	}
+	return rate.NewLimiter(rate.Limit(limit.RequestsPerSecond), max(limit.Burst, 1))
+}
+
+// Wait blocks until the request is allowed by the budget or ctx is done
+func (l *RateLimiter) Wait(ctx context.Context, method, resourceURL string) error {
+	if l == nil {
+		return nil
+	}
+	if isReadRequest(method, resourceURL) {
+		return l.read.Wait(ctx)
+	}
+	return l.write.Wait(ctx)
+}
+
+// isReadRequest reports whether the request does not modify anything
+func isReadRequest(method, resourceURL string) bool {
+	switch method {
+	case resty.MethodGet, resty.MethodHead, resty.MethodOptions:
+		return true
+	case resty.MethodPost:
+		return readOnlyPostURLs[resourceURL]
+	}
+	return false
+}
@@ -0,0 +1,106 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	client "github.com/IndianMax03/yandex-tracker-go-client"
+)
+
+// waitWithin waits on the limiter giving up after timeout
+func waitWithin(limiter *client.RateLimiter, timeout time.Duration, method, resourceURL string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return limiter.Wait(ctx, method, resourceURL)
+}
+
+func TestReadWriteRateLimiter(t *testing.T) {
+	// reads are unlimited, writes get a single token for the whole test
+	limiter := client.NewReadWriteRateLimiter(client.RateLimit{}, client.RateLimit{RequestsPerSecond: 0.001, Burst: 1})
+
+	reads := []struct{ method, resourceURL string }{
+		{http.MethodGet, "/issues/{issue_id}"},
+		{http.MethodPost, "/issues/_search"},
+		{http.MethodPost, "/issues/_count"},
+		{http.MethodPost, "/worklog/_search"},
+	}
+	for range 3 {
+		for _, read := range reads {
+			if err := waitWithin(limiter, 50*time.Millisecond, read.method, read.resourceURL); err != nil {
+				t.Fatalf("%s %s waited on the write budget: %v", read.method, read.resourceURL, err)
+			}
+		}
+	}
+
+	if err := waitWithin(limiter, 50*time.Millisecond, http.MethodPost, "/issues/"); err != nil {
+		t.Fatalf("first write failed: %v", err)
+	}
+	if err := waitWithin(limiter, 50*time.Millisecond, http.MethodPatch, "/issues/{issue_id}"); err == nil {
+		t.Error("second write was allowed beyond the write budget")
+	}
+}
+
+func TestRateLimiterSharedBudget(t *testing.T) {
+	limiter := client.NewRateLimiter(0.001, 1)
+	if err := waitWithin(limiter, 50*time.Millisecond, http.MethodPost, "/issues/"); err != nil {
+		t.Fatalf("first request failed: %v", err)
+	}
+	if err := waitWithin(limiter, 50*time.Millisecond, http.MethodGet, "/myself"); err == nil {
+		t.Error("read was allowed after the shared budget was spent by a write")
+	}
+}
+
+func TestRateLimiterCanceledWhileWaiting(t *testing.T) {
+	limiter := client.NewRateLimiter(0.001, 1)
+	srv, c := newTestClient(t, client.WithRateLimiter(limiter))
+	if _, err := c.GetMyself(); err != nil {
+		t.Fatalf("GetMyself failed: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		_, err := c.GetMyselfWithContext(ctx)
+		done <- err
+	}()
+	time.Sleep(20 * time.Millisecond)
+	cancel()
+
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("got error %v, want context.Canceled", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("request kept waiting after ctx was canceled")
+	}
+	if got := countRequests(srv, http.MethodGet, "/myself"); got != 1 {
+		t.Errorf("got %d requests, want the canceled one not sent", got)
+	}
+}
+
+func TestRateLimitWaitedReported(t *testing.T) {
+	metrics := &recordingMetrics{}
+	_, c := newTestClient(t, client.WithRateLimiter(client.NewRateLimiter(5, 1)), client.WithMetricsCollector(metrics))
+
+	for range 2 {
+		if _, err := c.GetIssue("TEST-1", false, false); !errors.Is(err, client.ErrNotFound) {
+			t.Fatalf("got error %v, want ErrNotFound", err)
+		}
+	}
+	waits := metrics.rateLimitWaits()
+	if len(waits) != 2 {
+		t.Fatalf("got %d reported waits, want 2", len(waits))
+	}
+	for _, wait := range waits {
+		if wait.method != http.MethodGet || wait.urlTemplate != "/issues/{issue_id}" {
+			t.Errorf("got wait reported for %s %s", wait.method, wait.urlTemplate)
+		}
+	}
+	if waits[1].wait < 100*time.Millisecond {
+		t.Errorf("got second wait %v, want at least 100ms at 5 requests per second", waits[1].wait)
+	}
+}
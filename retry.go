@@ -0,0 +1,191 @@
+// Package client provides methods, values and urls for interacting with Yandex Tracker
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+
+	"resty.dev/v3"
+)
+
+const (
+	defaultRetryMaxAttempts = 4
+	defaultRetryMinBackoff  = 200 * time.Millisecond
+	defaultRetryMaxBackoff  = 10 * time.Second
+)
+
+// RetryPolicy describes how requests failed with 429, transient 5xx or network errors are retried.
+// Scroll continuations are never retried, a repeated one could skip a portion of the scroll.
+type RetryPolicy struct {
+	// Maximum number of attempts including the first one. Values less than 2 disable retries.
+	MaxAttempts int
+	// Backoff before the first retry. It doubles with every next attempt.
+	MinBackoff time.Duration
+	// Upper bound of the backoff. Responses whose Retry-After header asks to wait longer
+	// are returned without retrying.
+	MaxBackoff time.Duration
+	// Allows retrying POST requests whose body carries a Unique key (see model.IssueCreateRequest.Unique).
+	// Tracker answers 409 instead of creating a duplicate, so such requests are safe to repeat.
+	RetryUniquePosts bool
+}
+
+// DefaultRetryPolicy returns retry policy with 4 attempts and exponential backoff from 200ms up to 10s
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: defaultRetryMaxAttempts,
+		MinBackoff:  defaultRetryMinBackoff,
+		MaxBackoff:  defaultRetryMaxBackoff,
+	}
+}
+
+// uniqueRequest is implemented by request bodies carrying the Unique key
+type uniqueRequest interface {
+	UniqueKey() string
+}
+
+// readOnlyPostURLs contains POST resources that do not modify anything and are safe to repeat
+var readOnlyPostURLs = map[string]bool{
+	issuesSearchURL:  true,
+	issuesCountURL:   true,
+	worklogSearchURL: true,
+}
+
+// allows reports whether the request may be retried under the policy
+func (p *RetryPolicy) allows(method, resourceURL string, requestBody any) bool {
+	if p == nil || p.MaxAttempts < 2 {
+		return false
+	}
+	switch method {
+	case resty.MethodGet, resty.MethodHead, resty.MethodOptions, resty.MethodPut, resty.MethodDelete:
+		return true
+	case resty.MethodPost:
+		if readOnlyPostURLs[resourceURL] {
+			return true
+		}
+		if u, ok := requestBody.(uniqueRequest); ok && p.RetryUniquePosts {
+			return u.UniqueKey() != ""
+		}
+	}
+	return false
+}
+
+// backoff returns the delay before the next attempt. It reports false if Retry-After exceeds MaxBackoff.
+func (p *RetryPolicy) backoff(attempt int, res *resty.Response) (time.Duration, bool) {
+	minBackoff, maxBackoff := p.MinBackoff, p.MaxBackoff
+	if minBackoff <= 0 {
+		minBackoff = defaultRetryMinBackoff
+	}
+	if maxBackoff < minBackoff {
+		maxBackoff = max(minBackoff, defaultRetryMaxBackoff)
+	}
+	if res != nil {
+		if delay, ok := parseRetryAfter(res.Header().Get("Retry-After")); ok {
+			return delay, delay <= maxBackoff
+		}
+	}
+	delay := minBackoff << (attempt - 1)
+	if delay <= 0 || delay > maxBackoff {
+		delay = maxBackoff
+	}
+	// equal jitter: half of the delay is fixed, the other half is random
+	half := delay / 2
+	return half + rand.N(delay-half+1), true
+}
+
+// shouldRetry reports whether the attempt failed with a transient error
+func shouldRetry(ctx context.Context, res *resty.Response, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	if err != nil {
+		return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+	switch res.StatusCode() {
+	case http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// parseRetryAfter parses Retry-After header given in seconds or as HTTP date
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+	return 0, false
+}
+
+// execute sends the request and retries it according to the retry policy.
+// Every attempt waits on the rate limiter and takes a fresh token from the token source.
+func (c *Client) execute(ctx context.Context, req *Request) (*resty.Response, error) {
+	policy := c.retryPolicy
+	// a repeated scroll continuation would move the scroll past the lost portion
+	retryable := policy.allows(req.Method, req.ResourceURL, req.Body) && req.QueryParams["scrollId"] == ""
+	for attempt := 1; ; attempt++ {
+		req.attempts = attempt
+		if attempt > 1 {
+			c.metrics.RequestRetried(req.Method, req.ResourceURL)
+		}
+		if c.rateLimiter != nil {
+			start := time.Now()
+			if err := c.rateLimiter.Wait(ctx, req.Method, req.ResourceURL); err != nil {
+				return nil, err
+			}
+			c.metrics.RateLimitWaited(req.Method, req.ResourceURL, time.Since(start))
+		}
+		restyReq := c.newRestyRequest(ctx, req)
+		if c.tokenSource != nil {
+			token, err := c.tokenSource.Token(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get token: %w", err)
+			}
+			restyReq.SetAuthScheme(token.Scheme).SetAuthToken(token.Value)
+		}
+		res, err := restyReq.Send()
+		if !retryable || attempt >= policy.MaxAttempts || !shouldRetry(ctx, res, err) {
+			return res, err
+		}
+		delay, ok := policy.backoff(attempt, res)
+		if !ok {
+			return res, err
+		}
+		c.logRetry(ctx, req, res, err, delay)
+		drainBody(res)
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return res, errors.Join(ctx.Err(), err)
+		case <-timer.C:
+		}
+	}
+}
+
+// drainBody releases the connection of the response that will not be read
+func drainBody(res *resty.Response) {
+	if res == nil || res.Body == nil {
+		return
+	}
+	_, _ = io.Copy(io.Discard, res.Body)
+	_ = res.Body.Close()
+}
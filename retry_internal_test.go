@@ -0,0 +1,78 @@
+package client
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	model "github.com/IndianMax03/yandex-tracker-go-client/model"
+	"resty.dev/v3"
+)
+
+func TestRetryPolicyAllows(t *testing.T) {
+	policy := &RetryPolicy{MaxAttempts: 3, RetryUniquePosts: true}
+	tests := []struct {
+		name   string
+		policy *RetryPolicy
+		method string
+		url    string
+		body   any
+		want   bool
+	}{
+		{name: "nil policy", method: resty.MethodGet, url: issuesGetURL},
+		{name: "single attempt", policy: &RetryPolicy{MaxAttempts: 1}, method: resty.MethodGet, url: issuesGetURL},
+		{name: "get", policy: policy, method: resty.MethodGet, url: issuesGetURL, want: true},
+		{name: "delete", policy: policy, method: resty.MethodDelete, url: issueDeleteCommentURL, want: true},
+		{name: "patch", policy: policy, method: resty.MethodPatch, url: issuesModifyURL},
+		{name: "search post", policy: policy, method: resty.MethodPost, url: issuesSearchURL, want: true},
+		{name: "post", policy: policy, method: resty.MethodPost, url: issuesCreateURL, body: &model.IssueCreateRequest{}},
+		{name: "unique post", policy: policy, method: resty.MethodPost, url: issuesCreateURL, body: &model.IssueCreateRequest{Unique: "u"}, want: true},
+		{
+			name:   "unique post not allowed",
+			policy: &RetryPolicy{MaxAttempts: 3},
+			method: resty.MethodPost,
+			url:    issuesCreateURL,
+			body:   &model.IssueCreateRequest{Unique: "u"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.allows(tt.method, tt.url, tt.body); got != tt.want {
+				t.Errorf("allows() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := &RetryPolicy{MaxAttempts: 5, MinBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+	withRetryAfter := func(value string) *resty.Response {
+		return &resty.Response{RawResponse: &http.Response{Header: http.Header{"Retry-After": {value}}}}
+	}
+	tests := []struct {
+		name     string
+		attempt  int
+		res      *resty.Response
+		min, max time.Duration
+		wantOK   bool
+	}{
+		{name: "first attempt", attempt: 1, min: 50 * time.Millisecond, max: 100 * time.Millisecond, wantOK: true},
+		{name: "third attempt", attempt: 3, min: 200 * time.Millisecond, max: 400 * time.Millisecond, wantOK: true},
+		{name: "capped", attempt: 10, min: 500 * time.Millisecond, max: time.Second, wantOK: true},
+		{name: "retry after", attempt: 1, res: withRetryAfter("1"), min: time.Second, max: time.Second, wantOK: true},
+		{name: "retry after exceeds max backoff", attempt: 1, res: withRetryAfter("30"), min: 30 * time.Second, max: 30 * time.Second},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for range 20 {
+				delay, ok := policy.backoff(tt.attempt, tt.res)
+				if ok != tt.wantOK {
+					t.Fatalf("backoff() ok = %v, want %v", ok, tt.wantOK)
+				}
+				if delay < tt.min || delay > tt.max {
+					t.Fatalf("backoff() = %v, want between %v and %v", delay, tt.min, tt.max)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package client_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	client "github.com/IndianMax03/yandex-tracker-go-client"
+	"github.com/IndianMax03/yandex-tracker-go-client/model"
+	"github.com/IndianMax03/yandex-tracker-go-client/trackertest"
+)
+
+func testRetryPolicy() *client.RetryPolicy {
+	return &client.RetryPolicy{
+		MaxAttempts:      3,
+		MinBackoff:       time.Millisecond,
+		MaxBackoff:       time.Second,
+		RetryUniquePosts: true,
+	}
+}
+
+func TestRetryTooManyRequestsWithRetryAfter(t *testing.T) {
+	srv, c := newTestClient(t, client.WithRetryPolicy(testRetryPolicy()))
+	key := addIssues(t, srv, "TEST", 1)[0]
+	srv.InjectFault(trackertest.Fault{Method: http.MethodGet, PathPrefix: "/issues/", StatusCode: http.StatusTooManyRequests, RetryAfter: "0", Times: 2})
+
+	issue, err := c.GetIssue(key, false, false)
+	if err != nil {
+		t.Fatalf("GetIssue failed: %v", err)
+	}
+	if issue.Key != key {
+		t.Errorf("got issue %s, want %s", issue.Key, key)
+	}
+	if got := countRequests(srv, http.MethodGet, "/issues/"+key); got != 3 {
+		t.Errorf("got %d attempts, want 3", got)
+	}
+}
+
+func TestRetryAfterExceedingMaxBackoffIsNotRetried(t *testing.T) {
+	srv, c := newTestClient(t, client.WithRetryPolicy(testRetryPolicy()))
+	key := addIssues(t, srv, "TEST", 1)[0]
+	srv.InjectFault(trackertest.Fault{Method: http.MethodGet, PathPrefix: "/issues/", StatusCode: http.StatusTooManyRequests, RetryAfter: "30"})
+
+	start := time.Now()
+	_, err := c.GetIssue(key, false, false)
+	if !errors.Is(err, client.ErrRateLimited) {
+		t.Fatalf("got error %v, want ErrRateLimited", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("request took %v, want it returned without waiting", elapsed)
+	}
+	if got := countRequests(srv, http.MethodGet, "/issues/"+key); got != 1 {
+		t.Errorf("got %d attempts, want 1", got)
+	}
+}
+
+func TestRetryServerErrors(t *testing.T) {
+	tests := []struct {
+		name         string
+		times        int
+		wantErr      error
+		wantAttempts int
+	}{
+		{name: "recovers", times: 2, wantAttempts: 3},
+		{name: "exhausts attempts", times: 0, wantErr: client.ErrServerError, wantAttempts: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, c := newTestClient(t, client.WithRetryPolicy(testRetryPolicy()))
+			key := addIssues(t, srv, "TEST", 1)[0]
+			srv.InjectFault(trackertest.Fault{Method: http.MethodGet, PathPrefix: "/issues/", StatusCode: http.StatusServiceUnavailable, Times: tt.times})
+
+			_, err := c.GetIssue(key, false, false)
+			if tt.wantErr == nil && err != nil || tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+			if got := countRequests(srv, http.MethodGet, "/issues/"+key); got != tt.wantAttempts {
+				t.Errorf("got %d attempts, want %d", got, tt.wantAttempts)
+			}
+		})
+	}
+}
+
+func TestRetryPosts(t *testing.T) {
+	tests := []struct {
+		name         string
+		unique       string
+		wantErr      error
+		wantAttempts int
+	}{
+		{name: "without unique key", wantErr: client.ErrServerError, wantAttempts: 1},
+		{name: "with unique key", unique: "retry-test-1", wantAttempts: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, c := newTestClient(t, client.WithRetryPolicy(testRetryPolicy()))
+			srv.InjectFault(trackertest.Fault{Method: http.MethodPost, PathPrefix: "/issues/", StatusCode: http.StatusBadGateway, Times: 1})
+
+			_, err := c.CreateIssue(&model.IssueCreateRequest{Summary: "Issue", Queue: model.Queue{Key: "TEST"}, Unique: tt.unique})
+			if tt.wantErr == nil && err != nil || tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+			if got := countRequests(srv, http.MethodPost, "/issues/"); got != tt.wantAttempts {
+				t.Errorf("got %d attempts, want %d", got, tt.wantAttempts)
+			}
+		})
+	}
+}
+
+func TestRetryReadOnlyPost(t *testing.T) {
+	srv, c := newTestClient(t, client.WithRetryPolicy(testRetryPolicy()))
+	addIssues(t, srv, "TEST", 2)
+	srv.InjectFault(trackertest.Fault{Method: http.MethodPost, PathPrefix: "/issues/_search", StatusCode: http.StatusInternalServerError, Times: 1})
+
+	issues, _, err := c.SearchIssuesPage(&model.IssueSearchRequest{Queue: "TEST"}, &model.PageRequest{})
+	if err != nil {
+		t.Fatalf("SearchIssuesPage failed: %v", err)
+	}
+	if len(issues) != 2 {
+		t.Errorf("got %d issues, want 2", len(issues))
+	}
+	if got := countRequests(srv, http.MethodPost, "/issues/_search"); got != 2 {
+		t.Errorf("got %d attempts, want 2", got)
+	}
+}
+
+func TestRetryWorklogSearch(t *testing.T) {
+	srv, c := newTestClient(t, client.WithRetryPolicy(testRetryPolicy()))
+	key := addIssues(t, srv, "TEST", 1)[0]
+	if _, err := c.CreateWorklog(key, &model.WorklogRequest{Start: time.Now(), Duration: time.Hour}); err != nil {
+		t.Fatalf("CreateWorklog failed: %v", err)
+	}
+	srv.InjectFault(trackertest.Fault{Method: http.MethodPost, PathPrefix: "/worklog/_search", StatusCode: http.StatusServiceUnavailable, Times: 1})
+
+	worklogs, _, err := c.SearchWorklogsPage(&model.WorklogSearchRequest{}, &model.PageRequest{})
+	if err != nil {
+		t.Fatalf("SearchWorklogsPage failed: %v", err)
+	}
+	if len(worklogs) != 1 {
+		t.Errorf("got %d worklogs, want 1", len(worklogs))
+	}
+	if got := countRequests(srv, http.MethodPost, "/worklog/_search"); got != 2 {
+		t.Errorf("got %d attempts, want 2", got)
+	}
+}
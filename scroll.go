@@ -0,0 +1,112 @@
+// Package client provides methods, values and urls for interacting with Yandex Tracker
+package client
+
+import (
+	"context"
+	"iter"
+
+	model "github.com/IndianMax03/yandex-tracker-go-client/model"
+)
+
+// ScrollCursor walks through issues found by scroll search. It is not safe for concurrent use.
+// The cursor must be closed to release the scroll context on the server.
+type ScrollCursor struct {
+	client    *Client
+	req       *model.IssueSearchRequest
+	scrollReq model.ScrollRequest
+
+	scrollID    string
+	scrollToken string
+	totalCount  int
+	started     bool
+	done        bool
+}
+
+// NewScrollCursor instantiates cursor over issues matching req. No requests are sent until Next is called.
+func (c *Client) NewScrollCursor(req *model.IssueSearchRequest, scrollReq *model.ScrollRequest) *ScrollCursor {
+	cursor := &ScrollCursor{
+		client: c,
+		req:    req,
+	}
+	if scrollReq != nil {
+		cursor.scrollReq = *scrollReq
+	}
+	return cursor
+}
+
+// Next returns the next portion of issues. Empty result means the scroll is exhausted.
+func (s *ScrollCursor) Next(ctx context.Context) ([]model.IssueResponse, error) {
+	if s.done {
+		return nil, nil
+	}
+	issues, scrollResp, err := s.client.SearchIssuesScrollWithContext(ctx, s.req, &s.scrollReq, s.scrollID)
+	if err != nil {
+		return nil, err
+	}
+	if !s.started {
+		s.started = true
+		s.totalCount = scrollResp.TotalCount
+	}
+	if scrollResp.ScrollID != "" {
+		s.scrollID = scrollResp.ScrollID
+	}
+	if scrollResp.ScrollToken != "" {
+		s.scrollToken = scrollResp.ScrollToken
+	}
+	if len(issues) == 0 {
+		s.done = true
+	}
+	return issues, nil
+}
+
+// Done reports whether the scroll is exhausted
+func (s *ScrollCursor) Done() bool {
+	return s.done
+}
+
+// TotalCount returns total number of found issues known after the first Next call
+func (s *ScrollCursor) TotalCount() int {
+	return s.totalCount
+}
+
+// ScrollID returns identifier of the scroll known after the first Next call
+func (s *ScrollCursor) ScrollID() string {
+	return s.scrollID
+}
+
+// Close releases the scroll context on the server. It is safe to call Close several times.
+func (s *ScrollCursor) Close(ctx context.Context) error {
+	s.done = true
+	if s.scrollID == "" || s.scrollToken == "" {
+		return nil
+	}
+	scrolls := map[string]string{s.scrollID: s.scrollToken}
+	s.scrollID, s.scrollToken = "", ""
+	return s.client.ClearScrollWithContext(ctx, scrolls)
+}
+
+// SearchIssuesScrollSeq returns iterator over issues found by scroll search.
+// The scroll is cleared when iteration ends, including break and errors.
+func (c *Client) SearchIssuesScrollSeq(ctx context.Context, req *model.IssueSearchRequest, scrollReq *model.ScrollRequest) iter.Seq2[model.IssueResponse, error] {
+	return func(yield func(model.IssueResponse, error) bool) {
+		cursor := c.NewScrollCursor(req, scrollReq)
+		// the scroll is cleared even if ctx is already canceled
+		defer cursor.Close(context.WithoutCancel(ctx))
+		for !cursor.Done() {
+			if err := ctx.Err(); err != nil {
+				yield(model.IssueResponse{}, err)
+				return
+			}
+			issues, err := cursor.Next(ctx)
+			if err != nil {
+				yield(model.IssueResponse{}, err)
+				return
+			}
+			for _, issue := range issues {
+				if !yield(issue, nil) {
+					return
+				}
+			}
+		}
+	}
+}
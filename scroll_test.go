@@ -0,0 +1,131 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"slices"
+	"testing"
+
+	client "github.com/IndianMax03/yandex-tracker-go-client"
+	"github.com/IndianMax03/yandex-tracker-go-client/model"
+	"github.com/IndianMax03/yandex-tracker-go-client/trackertest"
+)
+
+const scrollClearPath = "/system/search/scroll/_clear"
+
+// scrollIDs returns scrollId query params of the search requests in order
+func scrollIDs(srv *trackertest.Server) []string {
+	var ids []string
+	for _, req := range srv.Requests() {
+		if req.Method == http.MethodPost && req.Path == "/issues/_search" {
+			query, _ := url.ParseQuery(req.Query)
+			ids = append(ids, query.Get("scrollId"))
+		}
+	}
+	return ids
+}
+
+func TestScrollCursor(t *testing.T) {
+	srv, c := newTestClient(t)
+	keys := addIssues(t, srv, "TEST", 5)
+
+	cursor := c.NewScrollCursor(&model.IssueSearchRequest{Queue: "TEST"}, &model.ScrollRequest{PerScroll: 2})
+	var found []string
+	for !cursor.Done() {
+		issues, err := cursor.Next(context.Background())
+		if err != nil {
+			t.Fatalf("Next failed: %v", err)
+		}
+		for _, issue := range issues {
+			found = append(found, issue.Key)
+		}
+	}
+	if !slices.Equal(found, keys) {
+		t.Errorf("got keys %v, want %v", found, keys)
+	}
+	if cursor.TotalCount() != 5 || cursor.ScrollID() == "" {
+		t.Errorf("got total count %d and scroll ID %q", cursor.TotalCount(), cursor.ScrollID())
+	}
+	ids := scrollIDs(srv)
+	if len(ids) != 4 || ids[0] != "" {
+		t.Fatalf("got scroll IDs %q, want a new scroll followed by 3 continuations", ids)
+	}
+	for _, id := range ids[1:] {
+		if id != cursor.ScrollID() {
+			t.Errorf("got continuation with scroll ID %q, want %q", id, cursor.ScrollID())
+		}
+	}
+
+	for range 2 {
+		if err := cursor.Close(context.Background()); err != nil {
+			t.Fatalf("Close failed: %v", err)
+		}
+	}
+	if got := countRequests(srv, http.MethodPost, scrollClearPath); got != 1 {
+		t.Errorf("got %d clear requests, want 1", got)
+	}
+}
+
+func TestSearchIssuesScrollSeqClearsScroll(t *testing.T) {
+	tests := []struct {
+		name      string
+		breakAt   int
+		failAfter bool
+		want      int
+		wantErr   error
+	}{
+		{name: "exhausted", want: 5},
+		{name: "break", breakAt: 3, want: 3},
+		{name: "error", failAfter: true, want: 2, wantErr: client.ErrNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, c := newTestClient(t)
+			addIssues(t, srv, "TEST", 5)
+
+			var found int
+			var lastErr error
+			for _, err := range c.SearchIssuesScrollSeq(context.Background(), &model.IssueSearchRequest{Queue: "TEST"}, &model.ScrollRequest{PerScroll: 2}) {
+				if err != nil {
+					lastErr = err
+					continue
+				}
+				found++
+				if found == 1 && tt.failAfter {
+					srv.InjectFault(trackertest.Fault{PathPrefix: "/issues/_search", StatusCode: http.StatusNotFound, Times: 1})
+				}
+				if found == tt.breakAt {
+					break
+				}
+			}
+			if found != tt.want || !errors.Is(lastErr, tt.wantErr) {
+				t.Errorf("got %d issues and error %v, want %d and %v", found, lastErr, tt.want, tt.wantErr)
+			}
+			if got := countRequests(srv, http.MethodPost, scrollClearPath); got != 1 {
+				t.Errorf("got %d clear requests, want 1", got)
+			}
+		})
+	}
+}
+
+func TestScrollContinuationNotRetried(t *testing.T) {
+	srv, c := newTestClient(t, client.WithRetryPolicy(testRetryPolicy()))
+	addIssues(t, srv, "TEST", 5)
+
+	srv.InjectFault(trackertest.Fault{PathPrefix: "/issues/_search", StatusCode: http.StatusServiceUnavailable, Times: 1})
+	cursor := c.NewScrollCursor(&model.IssueSearchRequest{Queue: "TEST"}, &model.ScrollRequest{PerScroll: 2})
+	defer cursor.Close(context.Background())
+	if _, err := cursor.Next(context.Background()); err != nil {
+		t.Fatalf("new scroll was not retried: %v", err)
+	}
+
+	srv.InjectFault(trackertest.Fault{PathPrefix: "/issues/_search", StatusCode: http.StatusServiceUnavailable, Times: 1})
+	if _, err := cursor.Next(context.Background()); !errors.Is(err, client.ErrServerError) {
+		t.Fatalf("got error %v, want ErrServerError", err)
+	}
+	if got := scrollIDs(srv); len(got) != 3 {
+		t.Errorf("got search requests with scroll IDs %q, want 2 attempts of the new scroll and 1 continuation", got)
+	}
+}
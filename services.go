@@ -0,0 +1,449 @@
+// Package client provides methods, values and urls for interacting with Yandex Tracker
+package client
+
+import (
+	"context"
+	"iter"
+
+	model "github.com/IndianMax03/yandex-tracker-go-client/model"
+	"resty.dev/v3"
+)
+
+// IssuesService groups methods for issues, their search and transitions
+type IssuesService interface {
+	// Create creates a new issue.
+	Create(ctx context.Context, req *model.IssueCreateRequest) (*model.IssueResponse, error)
+	// Get gets the issue by key or ID.
+	Get(ctx context.Context, issueID string, includeAttachments, includeTransitions bool) (*model.IssueResponse, error)
+	// GetMany gets issues by keys in parallel chunks preserving the order of keys.
+	GetMany(ctx context.Context, keys []string, opts *GetIssuesOptions) ([]IssueResult, error)
+	// Count gets the number of issues matching the request.
+	Count(ctx context.Context, req *model.IssueCountRequest) (int, error)
+	// SearchPage finds a page of issues.
+	SearchPage(ctx context.Context, req *model.IssueSearchRequest, pageReq *model.PageRequest) ([]model.IssueResponse, *model.PageResponse, error)
+	// SearchAll finds all of the issues page by page.
+	SearchAll(ctx context.Context, req *model.IssueSearchRequest, opts ...PaginationOption) ([]model.IssueResponse, error)
+	// SearchSeq lazily iterates over found issues page by page.
+	SearchSeq(ctx context.Context, req *model.IssueSearchRequest, perPage int) iter.Seq2[model.IssueResponse, error]
+	// SearchStream finds a page of issues decoding them one by one into fn.
+	SearchStream(ctx context.Context, req *model.IssueSearchRequest, pageReq *model.PageRequest, fn func(issue *model.IssueResponse) error) (*model.PageResponse, error)
+	// SearchScroll finds a portion of issues using scrolling.
+	SearchScroll(ctx context.Context, req *model.IssueSearchRequest, scrollReq *model.ScrollRequest, scrollID string) ([]model.IssueResponse, *model.ScrollResponse, error)
+	// SearchScrollSeq lazily iterates over issues found by scroll search.
+	SearchScrollSeq(ctx context.Context, req *model.IssueSearchRequest, scrollReq *model.ScrollRequest) iter.Seq2[model.IssueResponse, error]
+	// ClearScroll releases scroll contexts.
+	ClearScroll(ctx context.Context, scrolls map[string]string) error
+	// Modify modifies the issue.
+	Modify(ctx context.Context, issueID string, req *model.IssueModifyRequest) (*model.IssueResponse, error)
+	// ModifyStatus executes the transition of the issue.
+	ModifyStatus(ctx context.Context, issueID string, transitionID string, req *model.IssueModifyStatusRequest) ([]model.IssueModifyStatusResponse, error)
+	// GetTransitions gets transitions available for the issue.
+	GetTransitions(ctx context.Context, issueID string) ([]model.IssueTransitionsResponse, error)
+	// Move moves the issue to another queue.
+	Move(ctx context.Context, issueID string, req *model.IssueMoveRequest) (*model.IssueResponse, error)
+	// MoveMany moves the issues to another queue one by one and returns new keys by the passed ones.
+	MoveMany(ctx context.Context, issueIDs []string, req *model.IssueMoveRequest) (map[string]string, error)
+	// Changelog gets a page of the issue changelog after the entry with ID req.FromID.
+	Changelog(ctx context.Context, issueID string, req *model.ChangelogRequest) ([]model.ChangelogResponse, error)
+	// ChangelogSeq lazily iterates over the issue changelog page by page.
+	ChangelogSeq(ctx context.Context, issueID string, req *model.ChangelogRequest) iter.Seq2[model.ChangelogResponse, error]
+}
+
+// CommentsService groups methods for issue comments
+type CommentsService interface {
+	// Create adds a comment to the issue.
+	Create(ctx context.Context, issueID string, req *model.CommentRequest) (*model.CommentResponse, error)
+	// Get gets the comment of the issue.
+	Get(ctx context.Context, issueID string, commentID int) (*model.CommentResponse, error)
+	// Page gets first pageReq.PerPage comments after the one with ID pageReq.FromID.
+	Page(ctx context.Context, issueID string, commentExpand string, pageReq *model.PageRequest) ([]model.CommentResponse, *model.PageResponse, error)
+	// All gets all of the comments of the issue.
+	All(ctx context.Context, issueID string, commentExpand string) ([]model.CommentResponse, error)
+	// Seq lazily iterates over comments of the issue.
+	Seq(ctx context.Context, issueID string, commentExpand string, perPage int) iter.Seq2[model.CommentResponse, error]
+	// Update updates the comment of the issue.
+	Update(ctx context.Context, issueID string, commentID int, req *model.CommentUpdateRequest) (*model.CommentResponse, error)
+	// Delete deletes the comment of the issue.
+	Delete(ctx context.Context, issueID string, commentID int) error
+}
+
+// AttachmentsService groups methods for issue attachments and temporary files
+type AttachmentsService interface {
+	// List gets attachments of the issue.
+	List(ctx context.Context, issueID string) ([]model.AttachmentFileResponse, error)
+	// Get gets the attachment of the issue.
+	Get(ctx context.Context, issueID, attachmentID string) (*model.AttachmentFileResponse, error)
+	// UploadTemporary uploads a temporary file to be attached later by ID.
+	UploadTemporary(ctx context.Context, multipartReq *resty.MultipartField) (*model.AttachmentFileResponse, error)
+	// Attach uploads a file and attaches it to the issue.
+	Attach(ctx context.Context, issueID string, multipartReq *resty.MultipartField) (*model.AttachmentFileResponse, error)
+	// Delete deletes the attachment of the issue.
+	Delete(ctx context.Context, issueID, fileID string) error
+}
+
+// LinksService groups methods for links between issues
+type LinksService interface {
+	// Create links the issue with another one.
+	Create(ctx context.Context, issueID string, req *model.IssueLinkRequest) (*model.IssueLinkResponse, error)
+	// List gets links of the issue.
+	List(ctx context.Context, issueID string) ([]model.IssueLinkResponse, error)
+	// Delete deletes the link of the issue.
+	Delete(ctx context.Context, issueID string, linkID int) error
+}
+
+// WorklogsService groups methods for records of time spent on issues
+type WorklogsService interface {
+	// Create adds a record of time spent on the issue.
+	Create(ctx context.Context, issueID string, req *model.WorklogRequest) (*model.WorklogResponse, error)
+	// List gets records of time spent on the issue.
+	List(ctx context.Context, issueID string) ([]model.WorklogResponse, error)
+	// Update updates the record of time spent on the issue.
+	Update(ctx context.Context, issueID string, worklogID int, req *model.WorklogUpdateRequest) (*model.WorklogResponse, error)
+	// Delete deletes the record of time spent on the issue.
+	Delete(ctx context.Context, issueID string, worklogID int) error
+	// SearchPage finds a page of records by author and creation date.
+	SearchPage(ctx context.Context, req *model.WorklogSearchRequest, pageReq *model.PageRequest) ([]model.WorklogResponse, *model.PageResponse, error)
+	// SearchAll finds all of the records by author and creation date page by page.
+	SearchAll(ctx context.Context, req *model.WorklogSearchRequest, opts ...PaginationOption) ([]model.WorklogResponse, error)
+	// SearchSeq lazily iterates over records found by author and creation date page by page.
+	SearchSeq(ctx context.Context, req *model.WorklogSearchRequest, perPage int) iter.Seq2[model.WorklogResponse, error]
+}
+
+// ChecklistsService groups methods for issue checklists. Mutating methods return the whole checklist.
+type ChecklistsService interface {
+	// Get gets checklist items of the issue.
+	Get(ctx context.Context, issueID string) ([]model.ChecklistItemResponse, error)
+	// Add adds an item to the checklist of the issue.
+	Add(ctx context.Context, issueID string, req *model.ChecklistItemRequest) ([]model.ChecklistItemResponse, error)
+	// Edit edits the checklist item.
+	Edit(ctx context.Context, issueID, itemID string, req *model.ChecklistItemRequest) ([]model.ChecklistItemResponse, error)
+	// Move places the checklist item before the one with beforeID.
+	Move(ctx context.Context, issueID, itemID, beforeID string) ([]model.ChecklistItemResponse, error)
+	// Delete deletes the checklist item.
+	Delete(ctx context.Context, issueID, itemID string) ([]model.ChecklistItemResponse, error)
+	// MarkDone checks the items whose text matches text ignoring case.
+	MarkDone(ctx context.Context, issueID, text string) ([]model.ChecklistItemResponse, error)
+}
+
+// UsersService groups methods for users
+type UsersService interface {
+	// Myself gets the user the client is authorized as.
+	Myself(ctx context.Context) (*model.UserResponse, error)
+	// Get gets the user by login or, if login is empty, by ID.
+	Get(ctx context.Context, login string, userID int) (*model.UserResponse, error)
+	// Page gets a page of users.
+	Page(ctx context.Context, pageReq *model.PageRequest) ([]model.UserResponse, *model.PageResponse, error)
+	// All gets all of the users page by page.
+	All(ctx context.Context, opts ...PaginationOption) ([]model.UserResponse, error)
+	// Seq lazily iterates over users page by page.
+	Seq(ctx context.Context, perPage int) iter.Seq2[model.UserResponse, error]
+}
+
+// ComponentsService groups methods for queue components
+type ComponentsService interface {
+	// Create creates a new component.
+	Create(ctx context.Context, req *model.ComponentRequest) (*model.ComponentResponse, error)
+	// Update updates the component of the given version.
+	Update(ctx context.Context, componentID, componentVersion int, req *model.ComponentUpdateRequest) (*model.ComponentResponse, error)
+	// Get gets the component.
+	Get(ctx context.Context, componentID int) (*model.ComponentResponse, error)
+	// Page gets a page of components.
+	Page(ctx context.Context, pageReq *model.PageRequest) ([]model.ComponentResponse, *model.PageResponse, error)
+	// All gets all of the components page by page.
+	All(ctx context.Context, opts ...PaginationOption) ([]model.ComponentResponse, error)
+	// Seq lazily iterates over components page by page.
+	Seq(ctx context.Context, perPage int) iter.Seq2[model.ComponentResponse, error]
+}
+
+// PrioritiesService groups methods for issue priorities
+type PrioritiesService interface {
+	// Get gets the priority.
+	Get(ctx context.Context, priorityID int, localized bool) (*model.PriorityResponse, error)
+	// Page gets a page of priorities.
+	Page(ctx context.Context, localized bool, pageReq *model.PageRequest) ([]model.PriorityResponse, *model.PageResponse, error)
+	// All gets all of the priorities page by page.
+	All(ctx context.Context, localized bool, opts ...PaginationOption) ([]model.PriorityResponse, error)
+	// Seq lazily iterates over priorities page by page.
+	Seq(ctx context.Context, localized bool, perPage int) iter.Seq2[model.PriorityResponse, error]
+}
+
+// Services delegate to the flat Client methods, so middlewares, retries and the rest of the pipeline apply to both
+type (
+	issuesService      struct{ c *Client }
+	commentsService    struct{ c *Client }
+	attachmentsService struct{ c *Client }
+	linksService       struct{ c *Client }
+	worklogsService    struct{ c *Client }
+	checklistsService  struct{ c *Client }
+	usersService       struct{ c *Client }
+	componentsService  struct{ c *Client }
+	prioritiesService  struct{ c *Client }
+)
+
+var (
+	_ IssuesService      = (*issuesService)(nil)
+	_ CommentsService    = (*commentsService)(nil)
+	_ AttachmentsService = (*attachmentsService)(nil)
+	_ LinksService       = (*linksService)(nil)
+	_ WorklogsService    = (*worklogsService)(nil)
+	_ ChecklistsService  = (*checklistsService)(nil)
+	_ UsersService       = (*usersService)(nil)
+	_ ComponentsService  = (*componentsService)(nil)
+	_ PrioritiesService  = (*prioritiesService)(nil)
+)
+
+func (c *Client) initServices() {
+	c.Issues = &issuesService{c}
+	c.Comments = &commentsService{c}
+	c.Attachments = &attachmentsService{c}
+	c.Links = &linksService{c}
+	c.Worklogs = &worklogsService{c}
+	c.Checklists = &checklistsService{c}
+	c.Users = &usersService{c}
+	c.Components = &componentsService{c}
+	c.Priorities = &prioritiesService{c}
+}
+
+func (s *issuesService) Create(ctx context.Context, req *model.IssueCreateRequest) (*model.IssueResponse, error) {
+	return s.c.CreateIssueWithContext(ctx, req)
+}
+
+func (s *issuesService) Get(ctx context.Context, issueID string, includeAttachments, includeTransitions bool) (*model.IssueResponse, error) {
+	return s.c.GetIssueWithContext(ctx, issueID, includeAttachments, includeTransitions)
+}
+
+func (s *issuesService) GetMany(ctx context.Context, keys []string, opts *GetIssuesOptions) ([]IssueResult, error) {
+	return s.c.GetIssuesWithContext(ctx, keys, opts)
+}
+
+func (s *issuesService) Count(ctx context.Context, req *model.IssueCountRequest) (int, error) {
+	return s.c.GetIssuesCountWithContext(ctx, req)
+}
+
+func (s *issuesService) SearchPage(ctx context.Context, req *model.IssueSearchRequest, pageReq *model.PageRequest) ([]model.IssueResponse, *model.PageResponse, error) {
+	return s.c.SearchIssuesPageWithContext(ctx, req, pageReq)
+}
+
+func (s *issuesService) SearchAll(ctx context.Context, req *model.IssueSearchRequest, opts ...PaginationOption) ([]model.IssueResponse, error) {
+	return s.c.SearchAllIssuesWithContext(ctx, req, opts...)
+}
+
+func (s *issuesService) SearchSeq(ctx context.Context, req *model.IssueSearchRequest, perPage int) iter.Seq2[model.IssueResponse, error] {
+	return s.c.SearchIssuesSeq(ctx, req, perPage)
+}
+
+func (s *issuesService) SearchStream(ctx context.Context, req *model.IssueSearchRequest, pageReq *model.PageRequest, fn func(issue *model.IssueResponse) error) (*model.PageResponse, error) {
+	return s.c.SearchIssuesStreamWithContext(ctx, req, pageReq, fn)
+}
+
+func (s *issuesService) SearchScroll(ctx context.Context, req *model.IssueSearchRequest, scrollReq *model.ScrollRequest, scrollID string) ([]model.IssueResponse, *model.ScrollResponse, error) {
+	return s.c.SearchIssuesScrollWithContext(ctx, req, scrollReq, scrollID)
+}
+
+func (s *issuesService) SearchScrollSeq(ctx context.Context, req *model.IssueSearchRequest, scrollReq *model.ScrollRequest) iter.Seq2[model.IssueResponse, error] {
+	return s.c.SearchIssuesScrollSeq(ctx, req, scrollReq)
+}
+
+func (s *issuesService) ClearScroll(ctx context.Context, scrolls map[string]string) error {
+	return s.c.ClearScrollWithContext(ctx, scrolls)
+}
+
+func (s *issuesService) Modify(ctx context.Context, issueID string, req *model.IssueModifyRequest) (*model.IssueResponse, error) {
+	return s.c.ModifyIssueWithContext(ctx, issueID, req)
+}
+
+func (s *issuesService) ModifyStatus(ctx context.Context, issueID string, transitionID string, req *model.IssueModifyStatusRequest) ([]model.IssueModifyStatusResponse, error) {
+	return s.c.ModifyIssueStatusWithContext(ctx, issueID, transitionID, req)
+}
+
+func (s *issuesService) GetTransitions(ctx context.Context, issueID string) ([]model.IssueTransitionsResponse, error) {
+	return s.c.GetIssueTransitionsWithContext(ctx, issueID)
+}
+
+func (s *issuesService) Move(ctx context.Context, issueID string, req *model.IssueMoveRequest) (*model.IssueResponse, error) {
+	return s.c.MoveIssueWithContext(ctx, issueID, req)
+}
+
+func (s *issuesService) MoveMany(ctx context.Context, issueIDs []string, req *model.IssueMoveRequest) (map[string]string, error) {
+	return s.c.MoveIssuesWithContext(ctx, issueIDs, req)
+}
+
+func (s *issuesService) Changelog(ctx context.Context, issueID string, req *model.ChangelogRequest) ([]model.ChangelogResponse, error) {
+	return s.c.GetIssueChangelogWithContext(ctx, issueID, req)
+}
+
+func (s *issuesService) ChangelogSeq(ctx context.Context, issueID string, req *model.ChangelogRequest) iter.Seq2[model.ChangelogResponse, error] {
+	return s.c.GetIssueChangelogSeq(ctx, issueID, req)
+}
+
+func (s *commentsService) Create(ctx context.Context, issueID string, req *model.CommentRequest) (*model.CommentResponse, error) {
+	return s.c.CreateCommentWithContext(ctx, issueID, req)
+}
+
+func (s *commentsService) Get(ctx context.Context, issueID string, commentID int) (*model.CommentResponse, error) {
+	return s.c.GetCommentWithContext(ctx, issueID, commentID)
+}
+
+func (s *commentsService) Page(ctx context.Context, issueID string, commentExpand string, pageReq *model.PageRequest) ([]model.CommentResponse, *model.PageResponse, error) {
+	return s.c.GetXCommentsAfterYWithContext(ctx, issueID, commentExpand, pageReq)
+}
+
+func (s *commentsService) All(ctx context.Context, issueID string, commentExpand string) ([]model.CommentResponse, error) {
+	return s.c.GetCommentsAllWithContext(ctx, issueID, commentExpand)
+}
+
+func (s *commentsService) Seq(ctx context.Context, issueID string, commentExpand string, perPage int) iter.Seq2[model.CommentResponse, error] {
+	return s.c.GetCommentsSeq(ctx, issueID, commentExpand, perPage)
+}
+
+func (s *commentsService) Update(ctx context.Context, issueID string, commentID int, req *model.CommentUpdateRequest) (*model.CommentResponse, error) {
+	return s.c.UpdateCommentWithContext(ctx, issueID, commentID, req)
+}
+
+func (s *commentsService) Delete(ctx context.Context, issueID string, commentID int) error {
+	return s.c.DeleteCommentWithContext(ctx, issueID, commentID)
+}
+
+func (s *attachmentsService) List(ctx context.Context, issueID string) ([]model.AttachmentFileResponse, error) {
+	return s.c.GetIssueAttachmentsWithContext(ctx, issueID)
+}
+
+func (s *attachmentsService) Get(ctx context.Context, issueID, attachmentID string) (*model.AttachmentFileResponse, error) {
+	return s.c.GetIssueAttachmentWithContext(ctx, issueID, attachmentID)
+}
+
+func (s *attachmentsService) UploadTemporary(ctx context.Context, multipartReq *resty.MultipartField) (*model.AttachmentFileResponse, error) {
+	return s.c.UploadTemporaryAttachmentWithContext(ctx, multipartReq)
+}
+
+func (s *attachmentsService) Attach(ctx context.Context, issueID string, multipartReq *resty.MultipartField) (*model.AttachmentFileResponse, error) {
+	return s.c.IssueAttachFileWithContext(ctx, issueID, multipartReq)
+}
+
+func (s *attachmentsService) Delete(ctx context.Context, issueID, fileID string) error {
+	return s.c.IssueDeleteFileWithContext(ctx, issueID, fileID)
+}
+
+func (s *linksService) Create(ctx context.Context, issueID string, req *model.IssueLinkRequest) (*model.IssueLinkResponse, error) {
+	return s.c.CreateIssueLinkWithContext(ctx, issueID, req)
+}
+
+func (s *linksService) List(ctx context.Context, issueID string) ([]model.IssueLinkResponse, error) {
+	return s.c.GetIssueLinksWithContext(ctx, issueID)
+}
+
+func (s *linksService) Delete(ctx context.Context, issueID string, linkID int) error {
+	return s.c.DeleteIssueLinkWithContext(ctx, issueID, linkID)
+}
+
+func (s *worklogsService) Create(ctx context.Context, issueID string, req *model.WorklogRequest) (*model.WorklogResponse, error) {
+	return s.c.CreateWorklogWithContext(ctx, issueID, req)
+}
+
+func (s *worklogsService) List(ctx context.Context, issueID string) ([]model.WorklogResponse, error) {
+	return s.c.GetIssueWorklogsWithContext(ctx, issueID)
+}
+
+func (s *worklogsService) Update(ctx context.Context, issueID string, worklogID int, req *model.WorklogUpdateRequest) (*model.WorklogResponse, error) {
+	return s.c.UpdateWorklogWithContext(ctx, issueID, worklogID, req)
+}
+
+func (s *worklogsService) Delete(ctx context.Context, issueID string, worklogID int) error {
+	return s.c.DeleteWorklogWithContext(ctx, issueID, worklogID)
+}
+
+func (s *worklogsService) SearchPage(ctx context.Context, req *model.WorklogSearchRequest, pageReq *model.PageRequest) ([]model.WorklogResponse, *model.PageResponse, error) {
+	return s.c.SearchWorklogsPageWithContext(ctx, req, pageReq)
+}
+
+func (s *worklogsService) SearchAll(ctx context.Context, req *model.WorklogSearchRequest, opts ...PaginationOption) ([]model.WorklogResponse, error) {
+	return s.c.SearchAllWorklogsWithContext(ctx, req, opts...)
+}
+
+func (s *worklogsService) SearchSeq(ctx context.Context, req *model.WorklogSearchRequest, perPage int) iter.Seq2[model.WorklogResponse, error] {
+	return s.c.SearchWorklogsSeq(ctx, req, perPage)
+}
+
+func (s *checklistsService) Get(ctx context.Context, issueID string) ([]model.ChecklistItemResponse, error) {
+	return s.c.GetChecklistWithContext(ctx, issueID)
+}
+
+func (s *checklistsService) Add(ctx context.Context, issueID string, req *model.ChecklistItemRequest) ([]model.ChecklistItemResponse, error) {
+	return s.c.AddChecklistItemWithContext(ctx, issueID, req)
+}
+
+func (s *checklistsService) Edit(ctx context.Context, issueID, itemID string, req *model.ChecklistItemRequest) ([]model.ChecklistItemResponse, error) {
+	return s.c.EditChecklistItemWithContext(ctx, issueID, itemID, req)
+}
+
+func (s *checklistsService) Move(ctx context.Context, issueID, itemID, beforeID string) ([]model.ChecklistItemResponse, error) {
+	return s.c.MoveChecklistItemWithContext(ctx, issueID, itemID, beforeID)
+}
+
+func (s *checklistsService) Delete(ctx context.Context, issueID, itemID string) ([]model.ChecklistItemResponse, error) {
+	return s.c.DeleteChecklistItemWithContext(ctx, issueID, itemID)
+}
+
+func (s *checklistsService) MarkDone(ctx context.Context, issueID, text string) ([]model.ChecklistItemResponse, error) {
+	return s.c.MarkChecklistItemsDoneWithContext(ctx, issueID, text)
+}
+
+func (s *usersService) Myself(ctx context.Context) (*model.UserResponse, error) {
+	return s.c.GetMyselfWithContext(ctx)
+}
+
+func (s *usersService) Get(ctx context.Context, login string, userID int) (*model.UserResponse, error) {
+	return s.c.GetUserWithContext(ctx, login, userID)
+}
+
+func (s *usersService) Page(ctx context.Context, pageReq *model.PageRequest) ([]model.UserResponse, *model.PageResponse, error) {
+	return s.c.GetUsersPageWithContext(ctx, pageReq)
+}
+
+func (s *usersService) All(ctx context.Context, opts ...PaginationOption) ([]model.UserResponse, error) {
+	return s.c.GetUsersAllWithContext(ctx, opts...)
+}
+
+func (s *usersService) Seq(ctx context.Context, perPage int) iter.Seq2[model.UserResponse, error] {
+	return s.c.GetUsersSeq(ctx, perPage)
+}
+
+func (s *componentsService) Create(ctx context.Context, req *model.ComponentRequest) (*model.ComponentResponse, error) {
+	return s.c.CreateComponentWithContext(ctx, req)
+}
+
+func (s *componentsService) Update(ctx context.Context, componentID, componentVersion int, req *model.ComponentUpdateRequest) (*model.ComponentResponse, error) {
+	return s.c.UpdateComponentWithContext(ctx, componentID, componentVersion, req)
+}
+
+func (s *componentsService) Get(ctx context.Context, componentID int) (*model.ComponentResponse, error) {
+	return s.c.GetComponentWithContext(ctx, componentID)
+}
+
+func (s *componentsService) Page(ctx context.Context, pageReq *model.PageRequest) ([]model.ComponentResponse, *model.PageResponse, error) {
+	return s.c.GetComponentsPageWithContext(ctx, pageReq)
+}
+
+func (s *componentsService) All(ctx context.Context, opts ...PaginationOption) ([]model.ComponentResponse, error) {
+	return s.c.GetComponentsAllWithContext(ctx, opts...)
+}
+
+func (s *componentsService) Seq(ctx context.Context, perPage int) iter.Seq2[model.ComponentResponse, error] {
+	return s.c.GetComponentsSeq(ctx, perPage)
+}
+
+func (s *prioritiesService) Get(ctx context.Context, priorityID int, localized bool) (*model.PriorityResponse, error) {
+	return s.c.GetPriorityWithContext(ctx, priorityID, localized)
+}
+
+func (s *prioritiesService) Page(ctx context.Context, localized bool, pageReq *model.PageRequest) ([]model.PriorityResponse, *model.PageResponse, error) {
+	return s.c.GetPrioritiesPageWithContext(ctx, localized, pageReq)
+}
+
+func (s *prioritiesService) All(ctx context.Context, localized bool, opts ...PaginationOption) ([]model.PriorityResponse, error) {
+	return s.c.GetAllPrioritiesWithContext(ctx, localized, opts...)
+}
+
+func (s *prioritiesService) Seq(ctx context.Context, localized bool, perPage int) iter.Seq2[model.PriorityResponse, error] {
+	return s.c.GetPrioritiesSeq(ctx, localized, perPage)
+}
@@ -0,0 +1,89 @@
+// Package client provides methods, values and urls for interacting with Yandex Tracker
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	model "github.com/IndianMax03/yandex-tracker-go-client/model"
+	"resty.dev/v3"
+)
+
+// SearchIssuesStream sends a request to find issues and passes them to fn one by one, see SearchIssuesStreamWithContext
+func (c *Client) SearchIssuesStream(req *model.IssueSearchRequest, pageReq *model.PageRequest, fn func(issue *model.IssueResponse) error) (*model.PageResponse, error) {
+	return c.SearchIssuesStreamWithContext(context.Background(), req, pageReq, fn)
+}
+
+// SearchIssuesStreamWithContext is like SearchIssuesPage, but decodes issues from the response body one by one
+// and passes each of them to fn instead of loading the whole page into memory. The issue must not be retained
+// by fn after it returns. An error returned by fn stops decoding and is returned as is.
+// Middlewares receive the response with the body not read yet.
+func (c *Client) SearchIssuesStreamWithContext(ctx context.Context, req *model.IssueSearchRequest, pageReq *model.PageRequest, fn func(issue *model.IssueResponse) error) (*model.PageResponse, error) {
+	if pageReq.PerPage <= 0 {
+		pageReq.PerPage = defaultPerPage
+	}
+	if pageReq.Page <= 0 {
+		pageReq.Page = 1
+	}
+	queryParams := make(map[string]string)
+	queryParams["perPage"] = strconv.Itoa(pageReq.PerPage)
+	queryParams["page"] = strconv.Itoa(pageReq.Page)
+
+	res, err := c.handle(ctx, &Request{
+		Method:      resty.MethodPost,
+		ResourceURL: issuesSearchURL,
+		QueryParams: queryParams,
+		Header:      http.Header{},
+		Body:        req,
+		stream:      true,
+	})
+	if res != nil && res.Body != nil {
+		defer res.Body.Close()
+	}
+	if err != nil {
+		return nil, err
+	}
+	if res.IsError() {
+		return nil, newAPIError(res)
+	}
+
+	totalPages, _ := strconv.Atoi(res.Header().Get("X-Total-Pages"))
+	totalCount, _ := strconv.Atoi(res.Header().Get("X-Total-Count"))
+	pageResp := model.PageResponse{
+		TotalPages: totalPages,
+		TotalCount: totalCount,
+	}
+	if err := decodeArray(res.Body, fn); err != nil {
+		return nil, err
+	}
+	return &pageResp, nil
+}
+
+// decodeArray decodes elements of JSON array one by one and passes each of them to fn
+func decodeArray[T any](body io.Reader, fn func(*T) error) error {
+	decoder := json.NewDecoder(body)
+	token, err := decoder.Token()
+	if err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '[' {
+		return fmt.Errorf("failed to decode response: expected array, got %v", token)
+	}
+	for decoder.More() {
+		var element T
+		if err := decoder.Decode(&element); err != nil {
+			return fmt.Errorf("failed to decode response: %w", err)
+		}
+		if err := fn(&element); err != nil {
+			return err
+		}
+	}
+	if _, err := decoder.Token(); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+// Package client provides methods, values and urls for interacting with Yandex Tracker
+package client
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+	"resty.dev/v3"
+)
+
+const instrumentationName = "github.com/IndianMax03/yandex-tracker-go-client"
+
+// Span attributes
+const (
+	attrMethod      = attribute.Key("http.request.method")
+	attrStatusCode  = attribute.Key("http.response.status_code")
+	attrResendCount = attribute.Key("http.request.resend_count")
+	attrURLTemplate = attribute.Key("url.template")
+	attrOrgID       = attribute.Key("tracker.org_id")
+	attrCloudOrgID  = attribute.Key("tracker.cloud_org_id")
+	attrPage        = attribute.Key("tracker.page")
+	attrFromID      = attribute.Key("tracker.from_id")
+	attrPageErrors  = attribute.Key("tracker.page_errors")
+	attrObjectCount = attribute.Key("tracker.object_count")
+)
+
+func newTracer(tracerProvider trace.TracerProvider) trace.Tracer {
+	if tracerProvider == nil {
+		tracerProvider = noop.NewTracerProvider()
+	}
+	return tracerProvider.Tracer(instrumentationName)
+}
+
+// SetTracerProvider enables OpenTelemetry tracing of requests. Nil provider disables tracing.
+func (c *Client) SetTracerProvider(tracerProvider trace.TracerProvider) {
+	c.tracer = newTracer(tracerProvider)
+}
+
+// orgAttribute returns attribute describing organization the client works with
+func (c *Client) orgAttribute() attribute.KeyValue {
+	if orgID := c.restyClient.Header().Get("X-Org-ID"); orgID != "" {
+		return attrOrgID.String(orgID)
+	}
+	return attrCloudOrgID.String(c.restyClient.Header().Get("X-Cloud-Org-ID"))
+}
+
+// traced wraps handler with a client span named after the resource URL template
+func (c *Client) traced(next Handler) Handler {
+	return func(ctx context.Context, req *Request) (*resty.Response, error) {
+		attrs := []attribute.KeyValue{
+			attrMethod.String(req.Method),
+			attrURLTemplate.String(req.ResourceURL),
+			c.orgAttribute(),
+		}
+		if page, err := strconv.Atoi(req.QueryParams["page"]); err == nil {
+			attrs = append(attrs, attrPage.Int(page))
+		}
+		if fromID, err := strconv.Atoi(req.QueryParams["id"]); err == nil {
+			attrs = append(attrs, attrFromID.Int(fromID))
+		}
+		ctx, span := c.tracer.Start(ctx, req.ResourceURL,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(attrs...),
+		)
+		defer span.End()
+
+		res, err := next(ctx, req)
+		if attempts := req.Attempts(); attempts > 1 {
+			span.SetAttributes(attrResendCount.Int(attempts - 1))
+		}
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return res, err
+		}
+		span.SetAttributes(attrStatusCode.Int(res.StatusCode()))
+		if res.IsError() {
+			span.SetStatus(codes.Error, http.StatusText(res.StatusCode()))
+		}
+		return res, err
+	}
+}
+
+// startPaginationSpan starts parent span of the requests sent by *All helpers
+func (c *Client) startPaginationSpan(ctx context.Context, name string) (context.Context, trace.Span) {
+	return c.tracer.Start(ctx, name, trace.WithAttributes(c.orgAttribute()))
+}
+
+// endPaginationSpan records the outcome of *All helper and ends its span
+func endPaginationSpan(span trace.Span, objectCount int, err error) {
+	span.SetAttributes(attrObjectCount.Int(objectCount))
+	if partialErr, ok := err.(*PartialResultError); ok {
+		span.SetAttributes(attrPageErrors.Int(len(partialErr.Errors)))
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
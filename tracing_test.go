@@ -0,0 +1,149 @@
+package client_test
+
+import (
+	"net/http"
+	"slices"
+	"testing"
+
+	client "github.com/IndianMax03/yandex-tracker-go-client"
+	"github.com/IndianMax03/yandex-tracker-go-client/model"
+	"github.com/IndianMax03/yandex-tracker-go-client/trackertest"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// newTracedClient returns client recording its spans
+func newTracedClient(t *testing.T, opts ...client.Option) (*trackertest.Server, *client.Client, *tracetest.SpanRecorder) {
+	t.Helper()
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	t.Cleanup(func() { _ = provider.Shutdown(t.Context()) })
+	srv, c := newTestClient(t, append(opts, client.WithTracerProvider(provider))...)
+	return srv, c, recorder
+}
+
+// spanAttributes returns attributes of the span by key
+func spanAttributes(span sdktrace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+	attrs := map[attribute.Key]attribute.Value{}
+	for _, attr := range span.Attributes() {
+		attrs[attr.Key] = attr.Value
+	}
+	return attrs
+}
+
+func TestTracedRequest(t *testing.T) {
+	srv, c, recorder := newTracedClient(t, client.WithRetryPolicy(testRetryPolicy()))
+	key := addIssues(t, srv, "TEST", 1)[0]
+	srv.InjectFault(trackertest.Fault{Method: http.MethodGet, StatusCode: http.StatusServiceUnavailable, Times: 1})
+
+	if _, err := c.GetIssue(key, false, false); err != nil {
+		t.Fatalf("GetIssue failed: %v", err)
+	}
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("got %d spans, want 1", len(spans))
+	}
+	span := spans[0]
+	if span.Name() != "/issues/{issue_id}" || span.SpanKind() != trace.SpanKindClient {
+		t.Errorf("got span %q of kind %v", span.Name(), span.SpanKind())
+	}
+	attrs := spanAttributes(span)
+	for name, want := range map[attribute.Key]attribute.Value{
+		"http.request.method":       attribute.StringValue(http.MethodGet),
+		"url.template":              attribute.StringValue("/issues/{issue_id}"),
+		"http.response.status_code": attribute.IntValue(http.StatusOK),
+		"http.request.resend_count": attribute.IntValue(1),
+		"tracker.org_id":            attribute.StringValue("trackertest-org"),
+	} {
+		if got := attrs[name]; got != want {
+			t.Errorf("got %s %v, want %v", name, got.Emit(), want.Emit())
+		}
+	}
+	if span.Status().Code != codes.Unset {
+		t.Errorf("got status %v, want unset", span.Status())
+	}
+}
+
+func TestTracedRequestError(t *testing.T) {
+	_, c, recorder := newTracedClient(t)
+	if _, err := c.GetIssue("TEST-404", false, false); err == nil {
+		t.Fatal("got no error for missing issue")
+	}
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("got %d spans, want 1", len(spans))
+	}
+	attrs := spanAttributes(spans[0])
+	if got := attrs["http.response.status_code"]; got != attribute.IntValue(http.StatusNotFound) {
+		t.Errorf("got status code %v, want 404", got.Emit())
+	}
+	if _, ok := attrs["http.request.resend_count"]; ok {
+		t.Error("got resend count for request sent once")
+	}
+	if spans[0].Status().Code != codes.Error {
+		t.Errorf("got status %v, want error", spans[0].Status())
+	}
+}
+
+func TestTracedPagination(t *testing.T) {
+	srv, c, recorder := newTracedClient(t)
+	addIssues(t, srv, "TEST", 120)
+
+	if _, err := c.SearchAllIssues(&model.IssueSearchRequest{Queue: "TEST"}); err != nil {
+		t.Fatalf("SearchAllIssues failed: %v", err)
+	}
+	spans := recorder.Ended()
+	if len(spans) != 4 {
+		t.Fatalf("got %d spans, want 3 pages and their parent", len(spans))
+	}
+	// the parent span ends after its children
+	parent := spans[len(spans)-1]
+	if parent.Name() != "SearchAllIssues" {
+		t.Fatalf("got parent span %q", parent.Name())
+	}
+	parentAttrs := spanAttributes(parent)
+	if parentAttrs["tracker.object_count"] != attribute.IntValue(120) || parentAttrs["tracker.org_id"] != attribute.StringValue("trackertest-org") {
+		t.Errorf("got parent attributes %v", parent.Attributes())
+	}
+
+	var pages []int64
+	for _, span := range spans[:len(spans)-1] {
+		if span.Name() != "/issues/_search" {
+			t.Errorf("got child span %q", span.Name())
+		}
+		if span.Parent().SpanID() != parent.SpanContext().SpanID() || span.SpanContext().TraceID() != parent.SpanContext().TraceID() {
+			t.Errorf("span of the page is not a child of the pagination span")
+		}
+		pages = append(pages, spanAttributes(span)["tracker.page"].AsInt64())
+	}
+	if !slices.Equal(pages, []int64{1, 2, 3}) {
+		t.Errorf("got pages %v, want 1, 2, 3", pages)
+	}
+}
+
+func TestTracedFromIDPagination(t *testing.T) {
+	srv, c, recorder := newTracedClient(t)
+	key := addIssues(t, srv, "TEST", 1)[0]
+	comment, err := c.CreateComment(key, &model.CommentRequest{Text: "comment"})
+	if err != nil {
+		t.Fatalf("CreateComment failed: %v", err)
+	}
+	recorder.Reset()
+
+	if _, err := c.GetCommentsAll(key, ""); err != nil {
+		t.Fatalf("GetCommentsAll failed: %v", err)
+	}
+	spans := recorder.Ended()
+	if len(spans) != 3 || spans[2].Name() != "GetCommentsAll" {
+		t.Fatalf("got %d spans, want 2 pages and their parent", len(spans))
+	}
+	if _, ok := spanAttributes(spans[0])["tracker.from_id"]; ok {
+		t.Error("got from ID of the first page")
+	}
+	if got := spanAttributes(spans[1])["tracker.from_id"]; got != attribute.IntValue(comment.ID) {
+		t.Errorf("got from ID %v of the second page, want %d", got.Emit(), comment.ID)
+	}
+}
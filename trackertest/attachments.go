@@ -0,0 +1,182 @@
+// Package trackertest provides an in-memory fake of Yandex Tracker API for tests.
+package trackertest
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+
+	model "github.com/IndianMax03/yandex-tracker-go-client/model"
+)
+
+// attachment is a stored file with its content
+type attachment struct {
+	model.AttachmentFileResponse
+	content []byte
+}
+
+// AttachmentContent returns the content of the file attached to the issue
+func (s *Server) AttachmentContent(issueID, attachmentID string) ([]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	found := s.findIssue(issueID)
+	if found == nil {
+		return nil, false
+	}
+	index := slices.IndexFunc(found.attachments, func(a *attachment) bool { return a.ID == attachmentID })
+	if index < 0 {
+		return nil, false
+	}
+	return slices.Clone(found.attachments[index].content), true
+}
+
+// readFile reads the file from the filename multipart field
+func (s *Server) readFile(w http.ResponseWriter, r *http.Request) *attachment {
+	file, header, err := r.FormFile("filename")
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "filename field is required: "+err.Error())
+		return nil
+	}
+	defer file.Close()
+	content, err := io.ReadAll(file)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "failed to read file: "+err.Error())
+		return nil
+	}
+	mimetype := header.Header.Get("Content-Type")
+	if mimetype == "" || mimetype == "application/octet-stream" {
+		mimetype = http.DetectContentType(content)
+	}
+	name := header.Filename
+	if name == "" {
+		name = "file"
+	}
+	id := strconv.Itoa(s.newID())
+	return &attachment{
+		AttachmentFileResponse: model.AttachmentFileResponse{
+			Self:      s.selfURL("/attachments/" + id),
+			ID:        id,
+			Name:      name,
+			CreatedBy: model.CreatedBy(s.userRef(&s.myself)),
+			CreatedAt: now(),
+			Mimetype:  mimetype,
+			Size:      len(content),
+		},
+		content: content,
+	}
+}
+
+// attachTemporaryFile moves the uploaded temporary file to the issue
+func (s *Server) attachTemporaryFile(i *issue, attachmentID string) *attachment {
+	a, ok := s.temporaryFiles[attachmentID]
+	if !ok {
+		return nil
+	}
+	delete(s.temporaryFiles, attachmentID)
+	s.addAttachment(i, a)
+	return a
+}
+
+func (s *Server) addAttachment(i *issue, a *attachment) {
+	a.Self = s.selfURL("/issues/" + i.Key + "/attachments/" + a.ID)
+	a.Content = a.Self + "/" + url.PathEscape(a.Name)
+	i.attachments = append(i.attachments, a)
+}
+
+// lookupAttachment writes 404 and returns -1 if the attachment from the path does not exist
+func lookupAttachment(w http.ResponseWriter, r *http.Request, i *issue) int {
+	attachmentID := r.PathValue("attachment_id")
+	index := slices.IndexFunc(i.attachments, func(a *attachment) bool { return a.ID == attachmentID })
+	if index < 0 {
+		notFound(w, "attachment", attachmentID)
+	}
+	return index
+}
+
+func (s *Server) getAttachments(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	found := s.lookupIssue(w, r)
+	if found == nil {
+		return
+	}
+	result := make([]model.AttachmentFileResponse, 0, len(found.attachments))
+	for _, a := range found.attachments {
+		result = append(result, a.AttachmentFileResponse)
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+func (s *Server) getAttachment(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	found := s.lookupIssue(w, r)
+	if found == nil {
+		return
+	}
+	index := lookupAttachment(w, r, found)
+	if index < 0 {
+		return
+	}
+	writeJSON(w, http.StatusOK, found.attachments[index].AttachmentFileResponse)
+}
+
+func (s *Server) downloadAttachment(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	found := s.lookupIssue(w, r)
+	if found == nil {
+		return
+	}
+	index := lookupAttachment(w, r, found)
+	if index < 0 {
+		return
+	}
+	a := found.attachments[index]
+	w.Header().Set("Content-Type", a.Mimetype)
+	_, _ = w.Write(a.content)
+}
+
+func (s *Server) attachFile(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	found := s.lookupIssue(w, r)
+	if found == nil {
+		return
+	}
+	a := s.readFile(w, r)
+	if a == nil {
+		return
+	}
+	s.addAttachment(found, a)
+	writeJSON(w, http.StatusCreated, a.AttachmentFileResponse)
+}
+
+func (s *Server) uploadTemporaryAttachment(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	a := s.readFile(w, r)
+	if a == nil {
+		return
+	}
+	a.Content = a.Self + "/" + url.PathEscape(a.Name)
+	s.temporaryFiles[a.ID] = a
+	writeJSON(w, http.StatusCreated, a.AttachmentFileResponse)
+}
+
+func (s *Server) deleteAttachment(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	found := s.lookupIssue(w, r)
+	if found == nil {
+		return
+	}
+	index := lookupAttachment(w, r, found)
+	if index < 0 {
+		return
+	}
+	found.attachments = slices.Delete(found.attachments, index, index+1)
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,117 @@
+// Package trackertest provides an in-memory fake of Yandex Tracker API for tests.
+package trackertest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+
+	model "github.com/IndianMax03/yandex-tracker-go-client/model"
+)
+
+// Changelog returns the changelog of the issue in order of changes
+func (s *Server) Changelog(issueID string) []model.ChangelogResponse {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	found := s.findIssue(issueID)
+	if found == nil {
+		return nil
+	}
+	result := make([]model.ChangelogResponse, 0, len(found.changelog))
+	for _, entry := range found.changelog {
+		result = append(result, *entry)
+	}
+	return result
+}
+
+// recordChange appends changelog entry with the fields that differ from before.
+// Entries without changed fields are skipped unless the issue was created.
+func (s *Server) recordChange(i *issue, changeType string, before model.IssueResponse) {
+	var fields []model.FieldChange
+	addChange := func(field string, changed bool, from, to any, fromEmpty, toEmpty bool) {
+		if changed {
+			fields = append(fields, model.FieldChange{
+				Field: model.ChangelogField{Self: s.selfURL("/fields/" + field), ID: field, Display: field},
+				From:  rawValue(from, fromEmpty),
+				To:    rawValue(to, toEmpty),
+			})
+		}
+	}
+	after := i.IssueResponse
+	addChange("key", before.Key != after.Key, before.Key, after.Key, before.Key == "", after.Key == "")
+	addChange("queue", before.Queue != after.Queue, before.Queue, after.Queue, before.Queue.Key == "", after.Queue.Key == "")
+	addChange("summary", before.Summary != after.Summary, before.Summary, after.Summary, before.Summary == "", after.Summary == "")
+	addChange("description", before.Description != after.Description, before.Description, after.Description, before.Description == "", after.Description == "")
+	addChange("type", before.Type != after.Type, before.Type, after.Type, before.Type.Key == "", after.Type.Key == "")
+	addChange(model.ChangelogFieldStatus, before.Status != after.Status, before.Status, after.Status, before.Status.Key == "", after.Status.Key == "")
+	addChange(model.ChangelogFieldPriority, before.Priority != after.Priority, before.Priority, after.Priority, before.Priority.Key == "", after.Priority.Key == "")
+	addChange(model.ChangelogFieldAssignee, before.Assignee != after.Assignee, before.Assignee, after.Assignee, before.Assignee.ID == "", after.Assignee.ID == "")
+	addChange(model.ChangelogFieldTags, !slices.Equal(before.Tags, after.Tags), before.Tags, after.Tags, len(before.Tags) == 0, len(after.Tags) == 0)
+	if len(fields) == 0 && changeType != model.ChangelogTypeIssueCreated {
+		return
+	}
+	id := fmt.Sprintf("%024x", s.newID())
+	i.changelog = append(i.changelog, &model.ChangelogResponse{
+		ID:        id,
+		Self:      s.selfURL("/issues/" + i.Key + "/changelog/" + id),
+		Issue:     model.ChangelogIssue{ObjectBaseResponse: issueRef(i), Key: i.Key},
+		UpdatedAt: after.UpdatedAt,
+		UpdatedBy: model.UpdatedBy(s.userRef(&s.myself)),
+		Type:      changeType,
+		Transport: "api",
+		Fields:    fields,
+	})
+}
+
+// rawValue encodes the field value or null if it is empty
+func rawValue(value any, empty bool) json.RawMessage {
+	if empty {
+		return json.RawMessage("null")
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return json.RawMessage("null")
+	}
+	return data
+}
+
+// getChangelog answers entries matching field and type parameters after the one given by the id parameter
+func (s *Server) getChangelog(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	found := s.lookupIssue(w, r)
+	if found == nil {
+		return
+	}
+	query := r.URL.Query()
+	var matched []*model.ChangelogResponse
+	for _, entry := range found.changelog {
+		if len(query["type"]) != 0 && !slices.Contains(query["type"], entry.Type) {
+			continue
+		}
+		if len(query["field"]) != 0 && !slices.ContainsFunc(entry.Fields, func(f model.FieldChange) bool {
+			return slices.Contains(query["field"], f.Field.ID)
+		}) {
+			continue
+		}
+		matched = append(matched, entry)
+	}
+	start := 0
+	if fromID := query.Get("id"); fromID != "" {
+		start = slices.IndexFunc(matched, func(entry *model.ChangelogResponse) bool { return entry.ID == fromID }) + 1
+		if start == 0 {
+			notFound(w, "changelog entry", fromID)
+			return
+		}
+	}
+	perPage, _ := strconv.Atoi(query.Get("perPage"))
+	perPage = orDefault(perPage, defaultPerPage)
+	page := matched[start:min(start+perPage, len(matched))]
+	result := make([]model.ChangelogResponse, 0, len(page))
+	for _, entry := range page {
+		result = append(result, *entry)
+	}
+	writeJSON(w, http.StatusOK, result)
+}
@@ -0,0 +1,166 @@
+// Package trackertest provides an in-memory fake of Yandex Tracker API for tests.
+package trackertest
+
+import (
+	"fmt"
+	"html"
+	"net/http"
+	"slices"
+	"time"
+
+	model "github.com/IndianMax03/yandex-tracker-go-client/model"
+)
+
+// lookupChecklistItem writes 404 and returns -1 if the checklist item from the path does not exist
+func lookupChecklistItem(w http.ResponseWriter, r *http.Request, i *issue) int {
+	itemID := r.PathValue("item_id")
+	index := slices.IndexFunc(i.ChecklistItems, func(item model.ChecklistItemResponse) bool { return item.ID == itemID })
+	if index < 0 {
+		notFound(w, "checklist item", itemID)
+	}
+	return index
+}
+
+// applyChecklistItem copies the set fields of the request to the item
+func (s *Server) applyChecklistItem(item *model.ChecklistItemResponse, req *model.ChecklistItemRequest) (ok bool, message string) {
+	if req.Text != "" {
+		item.Text = req.Text
+		item.TextHTML = "<p>" + html.EscapeString(req.Text) + "</p>"
+	}
+	if req.Checked != nil {
+		item.Checked = *req.Checked
+	}
+	if req.Assignee != "" {
+		user := s.findUser(req.Assignee)
+		if user == nil {
+			return false, "unknown assignee " + req.Assignee
+		}
+		item.Assignee = &model.ChecklistAssignee{
+			ID:          user.UID,
+			Display:     user.Display,
+			PassportUID: user.UID,
+			Login:       user.Login,
+			FirstName:   user.FirstName,
+			LastName:    user.LastName,
+			Email:       user.Email,
+			TrackerUID:  user.TrackerUID,
+		}
+	}
+	if req.Deadline != nil {
+		date, err := model.ParseTime(req.Deadline.Date)
+		if err != nil || date.IsZero() {
+			return false, "invalid deadline " + req.Deadline.Date
+		}
+		item.Deadline = &model.ChecklistDeadline{
+			Date:         req.Deadline.Date,
+			DeadlineType: orString(req.Deadline.DeadlineType, model.DeadlineTypeDate),
+			IsExceeded:   date.Before(time.Now()),
+		}
+	}
+	return true, ""
+}
+
+func (s *Server) getChecklist(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	found := s.lookupIssue(w, r)
+	if found == nil {
+		return
+	}
+	writeJSON(w, http.StatusOK, append([]model.ChecklistItemResponse{}, found.ChecklistItems...))
+}
+
+// addChecklistItem appends the item and answers the issue with the whole checklist
+func (s *Server) addChecklistItem(w http.ResponseWriter, r *http.Request) {
+	var req model.ChecklistItemRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	if req.Text == "" {
+		writeError(w, http.StatusBadRequest, "text is required")
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	found := s.lookupIssue(w, r)
+	if found == nil {
+		return
+	}
+	item := model.ChecklistItemResponse{ID: fmt.Sprintf("%024x", s.newID()), ChecklistItemType: "standard"}
+	if ok, message := s.applyChecklistItem(&item, &req); !ok {
+		writeError(w, http.StatusBadRequest, message)
+		return
+	}
+	found.ChecklistItems = append(found.ChecklistItems, item)
+	s.touch(found)
+	writeJSON(w, http.StatusCreated, s.view(found, nil))
+}
+
+func (s *Server) editChecklistItem(w http.ResponseWriter, r *http.Request) {
+	var req model.ChecklistItemRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	found := s.lookupIssue(w, r)
+	if found == nil {
+		return
+	}
+	index := lookupChecklistItem(w, r, found)
+	if index < 0 {
+		return
+	}
+	item := found.ChecklistItems[index]
+	if ok, message := s.applyChecklistItem(&item, &req); !ok {
+		writeError(w, http.StatusBadRequest, message)
+		return
+	}
+	found.ChecklistItems[index] = item
+	s.touch(found)
+	writeJSON(w, http.StatusOK, s.view(found, nil))
+}
+
+// moveChecklistItem places the item before the one given in the request
+func (s *Server) moveChecklistItem(w http.ResponseWriter, r *http.Request) {
+	var req model.ChecklistItemMoveRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	found := s.lookupIssue(w, r)
+	if found == nil {
+		return
+	}
+	index := lookupChecklistItem(w, r, found)
+	if index < 0 {
+		return
+	}
+	item := found.ChecklistItems[index]
+	items := slices.Delete(slices.Clone(found.ChecklistItems), index, index+1)
+	before := slices.IndexFunc(items, func(i model.ChecklistItemResponse) bool { return i.ID == req.Before })
+	if before < 0 {
+		writeError(w, http.StatusBadRequest, "unknown checklist item "+req.Before)
+		return
+	}
+	found.ChecklistItems = slices.Insert(items, before, item)
+	s.touch(found)
+	writeJSON(w, http.StatusOK, s.view(found, nil))
+}
+
+func (s *Server) deleteChecklistItem(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	found := s.lookupIssue(w, r)
+	if found == nil {
+		return
+	}
+	index := lookupChecklistItem(w, r, found)
+	if index < 0 {
+		return
+	}
+	found.ChecklistItems = slices.Delete(found.ChecklistItems, index, index+1)
+	s.touch(found)
+	writeJSON(w, http.StatusOK, s.view(found, nil))
+}
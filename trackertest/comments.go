@@ -0,0 +1,180 @@
+// Package trackertest provides an in-memory fake of Yandex Tracker API for tests.
+package trackertest
+
+import (
+	"html"
+	"net/http"
+	"slices"
+	"strconv"
+
+	model "github.com/IndianMax03/yandex-tracker-go-client/model"
+)
+
+// Comments returns the stored comments of the issue in order of creation
+func (s *Server) Comments(issueID string) []model.CommentResponse {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	found := s.findIssue(issueID)
+	if found == nil {
+		return nil
+	}
+	result := make([]model.CommentResponse, 0, len(found.comments))
+	for _, comment := range found.comments {
+		result = append(result, *comment)
+	}
+	return result
+}
+
+func (s *Server) addComment(i *issue, req *model.CommentRequest) *model.CommentResponse {
+	id := s.newID()
+	createdAt := now()
+	comment := &model.CommentResponse{
+		Self:      s.selfURL("/issues/" + i.Key + "/comments/" + strconv.Itoa(id)),
+		ID:        id,
+		LongID:    strconv.Itoa(id),
+		Text:      req.Text,
+		CreatedBy: model.CreatedBy(s.userRef(&s.myself)),
+		UpdatedBy: model.UpdatedBy(s.userRef(&s.myself)),
+		CreatedAt: createdAt,
+		UpdatedAt: createdAt,
+		Version:   1,
+		Type:      "standard",
+		Transport: "internal",
+	}
+	for _, login := range req.Summonees {
+		if user := s.findUser(login); user != nil {
+			comment.Summonees = append(comment.Summonees, model.Summonees(s.userRef(user)))
+		}
+	}
+	for _, attachmentID := range req.AttachmentIds {
+		if a := s.attachTemporaryFile(i, attachmentID); a != nil {
+			comment.Attachments = append(comment.Attachments, model.Attachment{Self: a.Self, ID: a.ID, Display: a.Name})
+		}
+	}
+	i.comments = append(i.comments, comment)
+	i.CommentWithoutExternalMessageCount++
+	i.LastCommentUpdatedAt = createdAt
+	return comment
+}
+
+// lookupComment writes 404 and returns -1 if the comment from the path does not exist
+func lookupComment(w http.ResponseWriter, r *http.Request, i *issue) int {
+	commentID := r.PathValue("comment_id")
+	index := slices.IndexFunc(i.comments, func(c *model.CommentResponse) bool {
+		return strconv.Itoa(c.ID) == commentID || c.LongID == commentID
+	})
+	if index < 0 {
+		notFound(w, "comment", commentID)
+	}
+	return index
+}
+
+// commentView returns the comment representation with optional HTML markup
+func commentView(c *model.CommentResponse, expand string) model.CommentResponse {
+	result := *c
+	if expand == model.ExpandHTML || expand == model.ExpandAll {
+		result.TextHTML = "<p>" + html.EscapeString(c.Text) + "</p>"
+	}
+	return result
+}
+
+func (s *Server) createComment(w http.ResponseWriter, r *http.Request) {
+	var req model.CommentRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	found := s.lookupIssue(w, r)
+	if found == nil {
+		return
+	}
+	if req.Text == "" && len(req.AttachmentIds) == 0 {
+		writeError(w, http.StatusBadRequest, "text is required")
+		return
+	}
+	writeJSON(w, http.StatusCreated, s.addComment(found, &req))
+}
+
+// getComments answers comments after the one given by the id parameter
+func (s *Server) getComments(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	found := s.lookupIssue(w, r)
+	if found == nil {
+		return
+	}
+	query := r.URL.Query()
+	perPage, _ := strconv.Atoi(query.Get("perPage"))
+	perPage = orDefault(perPage, defaultPerPage)
+	fromID, _ := strconv.Atoi(query.Get("id"))
+	start := slices.IndexFunc(found.comments, func(c *model.CommentResponse) bool { return c.ID > fromID })
+	if start < 0 {
+		start = len(found.comments)
+	}
+	page := found.comments[start:min(start+perPage, len(found.comments))]
+	w.Header().Set("X-Total-Count", strconv.Itoa(len(found.comments)))
+	result := make([]model.CommentResponse, 0, len(page))
+	for _, comment := range page {
+		result = append(result, commentView(comment, query.Get("expand")))
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+func (s *Server) getComment(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	found := s.lookupIssue(w, r)
+	if found == nil {
+		return
+	}
+	index := lookupComment(w, r, found)
+	if index < 0 {
+		return
+	}
+	writeJSON(w, http.StatusOK, commentView(found.comments[index], r.URL.Query().Get("expand")))
+}
+
+func (s *Server) updateComment(w http.ResponseWriter, r *http.Request) {
+	var req model.CommentUpdateRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	found := s.lookupIssue(w, r)
+	if found == nil {
+		return
+	}
+	index := lookupComment(w, r, found)
+	if index < 0 {
+		return
+	}
+	comment := found.comments[index]
+	comment.Text = req.Text
+	for _, attachmentID := range req.AttachmentIds {
+		if a := s.attachTemporaryFile(found, attachmentID); a != nil {
+			comment.Attachments = append(comment.Attachments, model.Attachment{Self: a.Self, ID: a.ID, Display: a.Name})
+		}
+	}
+	comment.Version++
+	comment.UpdatedAt = now()
+	comment.UpdatedBy = model.UpdatedBy(s.userRef(&s.myself))
+	writeJSON(w, http.StatusOK, comment)
+}
+
+func (s *Server) deleteComment(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	found := s.lookupIssue(w, r)
+	if found == nil {
+		return
+	}
+	index := lookupComment(w, r, found)
+	if index < 0 {
+		return
+	}
+	found.comments = slices.Delete(found.comments, index, index+1)
+	found.CommentWithoutExternalMessageCount--
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,287 @@
+// Package trackertest provides an in-memory fake of Yandex Tracker API for tests.
+package trackertest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	model "github.com/IndianMax03/yandex-tracker-go-client/model"
+)
+
+// Myself returns the user the server treats as the author of every request
+func (s *Server) Myself() model.UserResponse {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.myself
+}
+
+// AddUser stores the user and returns it with assigned UID, Self and Display.
+// Users can then be referenced by login or UID as assignees, followers and summonees.
+func (s *Server) AddUser(user model.UserResponse) model.UserResponse {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return *s.addUser(user)
+}
+
+func (s *Server) addUser(user model.UserResponse) *model.UserResponse {
+	if user.UID == 0 {
+		user.UID = s.newID()
+	}
+	user.TrackerUID = user.UID
+	user.Self = s.selfURL("/users/" + strconv.Itoa(user.UID))
+	if user.Display == "" {
+		user.Display = strings.TrimSpace(user.FirstName + " " + user.LastName)
+	}
+	if user.Email == "" {
+		user.Email = user.Login + "@example.com"
+	}
+	user.HasLicense = true
+	stored := &user
+	s.users = append(s.users, stored)
+	return stored
+}
+
+// findUser looks the user up by login, login:<login> or UID
+func (s *Server) findUser(loginOrID string) *model.UserResponse {
+	login, byLogin := strings.CutPrefix(loginOrID, "login:")
+	for _, user := range s.users {
+		if user.Login == login || !byLogin && strconv.Itoa(user.UID) == loginOrID {
+			return user
+		}
+	}
+	return nil
+}
+
+func (s *Server) userRef(user *model.UserResponse) model.ObjectBaseResponse {
+	return model.ObjectBaseResponse{Self: user.Self, ID: strconv.Itoa(user.UID), Display: user.Display}
+}
+
+// userValues returns UID and login of the referenced user for filtering
+func (s *Server) userValues(uid string) []string {
+	if uid == "" {
+		return nil
+	}
+	if user := s.findUser(uid); user != nil {
+		return []string{uid, user.Login}
+	}
+	return []string{uid}
+}
+
+// AddPriority stores the priority and returns it with assigned ID and Self.
+// The server is seeded with trivial, minor, normal, critical and blocker priorities.
+func (s *Server) AddPriority(priority model.PriorityResponse) model.PriorityResponse {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	priority.ID = len(s.priorities) + 1
+	priority.Self = s.selfURL("/priorities/" + strconv.Itoa(priority.ID))
+	priority.Version = max(priority.Version, 1)
+	s.priorities = append(s.priorities, &priority)
+	return priority
+}
+
+// findPriority looks the priority up by key or ID
+func (s *Server) findPriority(keyOrID string) *model.PriorityResponse {
+	for _, priority := range s.priorities {
+		if priority.Key == keyOrID || strconv.Itoa(priority.ID) == keyOrID {
+			return priority
+		}
+	}
+	return nil
+}
+
+// localizedName returns English name of the priority
+func localizedName(name any) string {
+	switch v := name.(type) {
+	case map[string]string:
+		return v["en"]
+	case string:
+		return v
+	}
+	return ""
+}
+
+// priorityView returns the priority with the name localized on request
+func priorityView(priority *model.PriorityResponse, r *http.Request) model.PriorityResponse {
+	result := *priority
+	if localized, err := strconv.ParseBool(r.URL.Query().Get("localized")); err != nil || localized {
+		result.Name = localizedName(priority.Name)
+	}
+	return result
+}
+
+// AddComponent stores the component as if it was created by the current user and returns it
+func (s *Server) AddComponent(req model.ComponentRequest) model.ComponentResponse {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return *s.addComponent(&req)
+}
+
+func (s *Server) addComponent(req *model.ComponentRequest) *model.ComponentResponse {
+	id := s.newID()
+	component := &model.ComponentResponse{
+		Self:    s.selfURL("/components/" + strconv.Itoa(id)),
+		ID:      id,
+		Version: 1,
+		Name:    req.Name,
+		Queue: model.IssueQueue{
+			ObjectBaseResponse: model.ObjectBaseResponse{Self: s.selfURL("/queues/" + req.Queue), ID: req.Queue, Display: req.Queue},
+			Key:                req.Queue,
+		},
+		Description: req.Description,
+		Lead:        req.Lead,
+		AssignAuto:  req.AssignAuto,
+	}
+	s.components = append(s.components, component)
+	return component
+}
+
+// findComponent looks the component up by name or ID
+func (s *Server) findComponent(nameOrID string) *model.ComponentResponse {
+	for _, component := range s.components {
+		if component.Name == nameOrID || strconv.Itoa(component.ID) == nameOrID {
+			return component
+		}
+	}
+	return nil
+}
+
+func (s *Server) componentRef(component *model.ComponentResponse) model.ObjectBaseResponse {
+	return model.ObjectBaseResponse{Self: component.Self, ID: strconv.Itoa(component.ID), Display: component.Name}
+}
+
+// lookupComponent writes 404 and returns nil if the component from the path does not exist
+func (s *Server) lookupComponent(w http.ResponseWriter, r *http.Request) *model.ComponentResponse {
+	componentID := r.PathValue("component_id")
+	for _, component := range s.components {
+		if strconv.Itoa(component.ID) == componentID {
+			return component
+		}
+	}
+	notFound(w, "component", componentID)
+	return nil
+}
+
+func (s *Server) getPriorities(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	page := paginate(w, r, s.priorities)
+	result := make([]model.PriorityResponse, 0, len(page))
+	for _, priority := range page {
+		result = append(result, priorityView(priority, r))
+	}
+	writeCacheableJSON(w, r, result)
+}
+
+func (s *Server) getPriority(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	priorityID := r.PathValue("priority_id")
+	priority := s.findPriority(priorityID)
+	if priority == nil {
+		notFound(w, "priority", priorityID)
+		return
+	}
+	writeCacheableJSON(w, r, priorityView(priority, r))
+}
+
+func (s *Server) getMyself(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	writeCacheableJSON(w, r, s.myself)
+}
+
+func (s *Server) getUsers(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	page := paginate(w, r, s.users)
+	result := make([]model.UserResponse, 0, len(page))
+	for _, user := range page {
+		result = append(result, *user)
+	}
+	writeCacheableJSON(w, r, result)
+}
+
+func (s *Server) getUser(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	loginOrID := r.PathValue("login_or_user_id")
+	user := s.findUser(loginOrID)
+	if user == nil {
+		notFound(w, "user", loginOrID)
+		return
+	}
+	writeCacheableJSON(w, r, user)
+}
+
+func (s *Server) createComponent(w http.ResponseWriter, r *http.Request) {
+	var req model.ComponentRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	if req.Name == "" || req.Queue == "" {
+		writeError(w, http.StatusBadRequest, "name and queue are required")
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, component := range s.components {
+		if component.Name == req.Name && component.Queue.Key == req.Queue {
+			writeError(w, http.StatusConflict, fmt.Sprintf("component %s already exists in queue %s", req.Name, req.Queue))
+			return
+		}
+	}
+	writeJSON(w, http.StatusCreated, s.addComponent(&req))
+}
+
+func (s *Server) getComponents(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	page := paginate(w, r, s.components)
+	result := make([]model.ComponentResponse, 0, len(page))
+	for _, component := range page {
+		result = append(result, *component)
+	}
+	writeCacheableJSON(w, r, result)
+}
+
+func (s *Server) getComponent(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	component := s.lookupComponent(w, r)
+	if component == nil {
+		return
+	}
+	writeCacheableJSON(w, r, component)
+}
+
+// updateComponent modifies the component if the version parameter matches the current one
+func (s *Server) updateComponent(w http.ResponseWriter, r *http.Request) {
+	var req model.ComponentUpdateRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	component := s.lookupComponent(w, r)
+	if component == nil {
+		return
+	}
+	if version := r.URL.Query().Get("version"); version != strconv.Itoa(component.Version) {
+		writeError(w, http.StatusConflict, fmt.Sprintf("component %d has version %d", component.ID, component.Version))
+		return
+	}
+	if req.Name != "" {
+		component.Name = req.Name
+	}
+	if req.Description != "" {
+		component.Description = req.Description
+	}
+	if req.Lead != "" {
+		component.Lead = req.Lead
+	}
+	component.AssignAuto = req.AssignAuto
+	component.Version++
+	writeJSON(w, http.StatusOK, component)
+}
@@ -0,0 +1,671 @@
+// Package trackertest provides an in-memory fake of Yandex Tracker API for tests.
+package trackertest
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	model "github.com/IndianMax03/yandex-tracker-go-client/model"
+)
+
+// issue is a stored issue with its comments and attachments
+type issue struct {
+	model.IssueResponse
+	unique      string
+	comments    []*model.CommentResponse
+	attachments []*attachment
+	changelog   []*model.ChangelogResponse
+}
+
+// scroll is a scroll search context
+type scroll struct {
+	keys      []string
+	perScroll int
+	token     string
+}
+
+// statusTransition is a workflow edge leading to the status
+type statusTransition struct {
+	id string
+	to string
+}
+
+// statusNames contains display names of the workflow statuses
+var statusNames = map[string]string{
+	"open":       "Open",
+	"inProgress": "In progress",
+	"needInfo":   "Need info",
+	"closed":     "Closed",
+}
+
+// workflow contains transitions available from each status
+var workflow = map[string][]statusTransition{
+	"open": {
+		{model.InProgrssTransitionID, "inProgress"},
+		{model.NeedInfoTransitionID, "needInfo"},
+		{model.CloseTransitionID, "closed"},
+	},
+	"inProgress": {
+		{model.StopProgressTransitionID, "open"},
+		{model.NeedInfoTransitionID, "needInfo"},
+		{model.CloseTransitionID, "closed"},
+	},
+	"needInfo": {
+		{model.ProvideInfoTransitionID, "inProgress"},
+		{model.CloseTransitionID, "closed"},
+	},
+	"closed": {
+		{model.ReopenTransitionID, "open"},
+	},
+}
+
+// AddIssue stores the issue as if it was created by the current user and returns it
+func (s *Server) AddIssue(req model.IssueCreateRequest) (model.IssueResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	created, status, message := s.createIssueLocked(&req)
+	if status != http.StatusCreated {
+		return model.IssueResponse{}, fmt.Errorf("trackertest: %s", message)
+	}
+	return created.IssueResponse, nil
+}
+
+// Issue returns the stored issue by key or ID
+func (s *Server) Issue(issueID string) (model.IssueResponse, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	found := s.findIssue(issueID)
+	if found == nil {
+		return model.IssueResponse{}, false
+	}
+	result := found.IssueResponse
+	result.ChecklistItems = slices.Clone(result.ChecklistItems)
+	return result, true
+}
+
+// findIssue looks the issue up by key or ID
+func (s *Server) findIssue(issueID string) *issue {
+	if found, ok := s.issueByKey[issueID]; ok {
+		return found
+	}
+	for _, i := range s.issues {
+		if i.ID == issueID {
+			return i
+		}
+	}
+	return nil
+}
+
+// lookupIssue writes 404 and returns nil if the issue from the path does not exist
+func (s *Server) lookupIssue(w http.ResponseWriter, r *http.Request) *issue {
+	issueID := r.PathValue("issue_id")
+	found := s.findIssue(issueID)
+	if found == nil {
+		notFound(w, "issue", issueID)
+	}
+	return found
+}
+
+func (s *Server) createIssueLocked(req *model.IssueCreateRequest) (*issue, int, string) {
+	queue := req.Queue.Key
+	if queue == "" {
+		queue = req.Queue.ID
+	}
+	if req.Summary == "" || queue == "" {
+		return nil, http.StatusBadRequest, "summary and queue are required"
+	}
+	if req.Unique != "" {
+		if key, ok := s.uniqueKeys[req.Unique]; ok {
+			return nil, http.StatusConflict, fmt.Sprintf("issue %s already exists with unique %s", key, req.Unique)
+		}
+	}
+	priority := s.findPriority(orString(refKey(req.Priority), "normal"))
+	if priority == nil {
+		return nil, http.StatusBadRequest, "unknown priority " + refKey(req.Priority)
+	}
+	var assignee model.IssueAssignee
+	if login := refKey(req.Assignee); login != "" {
+		user := s.findUser(login)
+		if user == nil {
+			return nil, http.StatusBadRequest, "unknown assignee " + login
+		}
+		assignee = model.IssueAssignee(s.userRef(user))
+	}
+	var followers model.IssueFollowers
+	for _, follower := range req.Followers {
+		user := s.findUser(refKey(follower))
+		if user == nil {
+			return nil, http.StatusBadRequest, "unknown follower " + refKey(follower)
+		}
+		followers = append(followers, s.userRef(user))
+	}
+	var components []model.IssueComponent
+	for _, name := range req.Components {
+		component := s.findComponent(name)
+		if component == nil {
+			return nil, http.StatusBadRequest, "unknown component " + name
+		}
+		components = append(components, model.IssueComponent(s.componentRef(component)))
+	}
+
+	s.queueCounts[queue]++
+	key := fmt.Sprintf("%s-%d", queue, s.queueCounts[queue])
+	issueType := orString(refKey(req.Type), "task")
+	createdAt := now()
+	created := &issue{
+		IssueResponse: model.IssueResponse{
+			Self:        s.selfURL("/issues/" + key),
+			ID:          fmt.Sprintf("%024x", s.newID()),
+			Key:         key,
+			Version:     1,
+			Summary:     req.Summary,
+			Description: req.Description,
+			Type: model.IssueType{
+				ObjectBaseResponse: model.ObjectBaseResponse{Self: s.selfURL("/issuetypes/" + issueType), ID: issueType, Display: issueType},
+				Key:                issueType,
+			},
+			Priority: model.IssuePriority{
+				ObjectBaseResponse: model.ObjectBaseResponse{Self: priority.Self, ID: strconv.Itoa(priority.ID), Display: localizedName(priority.Name)},
+				Key:                priority.Key,
+			},
+			CreatedAt:  createdAt,
+			UpdatedAt:  createdAt,
+			CreatedBy:  model.CreatedBy(s.userRef(&s.myself)),
+			UpdatedBy:  model.UpdatedBy(s.userRef(&s.myself)),
+			Assignee:   assignee,
+			Followers:  followers,
+			Queue:      model.IssueQueue{ObjectBaseResponse: model.ObjectBaseResponse{Self: s.selfURL("/queues/" + queue), ID: queue, Display: queue}, Key: queue},
+			Tags:       req.Tags,
+			Components: components,
+		},
+		unique: req.Unique,
+	}
+	if parent := s.findIssue(refKey(req.Parent)); parent != nil {
+		created.Parent = model.IssueParent{ObjectBaseResponse: issueRef(parent), Key: parent.Key}
+	}
+	s.setStatus(created, "open")
+	for _, id := range slices.Concat(req.AttachmentIds, req.DescriptionAttachmentIds) {
+		s.attachTemporaryFile(created, id)
+	}
+	s.recordChange(created, model.ChangelogTypeIssueCreated, created.IssueResponse)
+	s.issues = append(s.issues, created)
+	s.issueByKey[key] = created
+	if req.Unique != "" {
+		s.uniqueKeys[req.Unique] = key
+	}
+	return created, http.StatusCreated, ""
+}
+
+func (s *Server) setStatus(i *issue, status string) {
+	i.Status = model.IssueStatus{
+		ObjectBaseResponse: model.ObjectBaseResponse{Self: s.selfURL("/statuses/" + status), ID: status, Display: statusNames[status]},
+		Key:                status,
+	}
+	i.StatusStartTime = now()
+}
+
+// touch bumps the version of the modified issue
+func (s *Server) touch(i *issue) {
+	i.Version++
+	i.UpdatedAt = now()
+	i.UpdatedBy = model.UpdatedBy(s.userRef(&s.myself))
+}
+
+func issueRef(i *issue) model.ObjectBaseResponse {
+	return model.ObjectBaseResponse{Self: i.Self, ID: i.ID, Display: i.Summary}
+}
+
+// view returns the issue representation with optional attachments and transitions
+func (s *Server) view(i *issue, expand []string) model.IssueResponse {
+	result := i.IssueResponse
+	if slices.Contains(expand, "attachments") {
+		result.Attachments = []model.Attachment{}
+		for _, a := range i.attachments {
+			result.Attachments = append(result.Attachments, model.Attachment{Self: a.Self, ID: a.ID, Display: a.Name})
+		}
+	}
+	if slices.Contains(expand, "transitions") {
+		result.Transitions = []model.Transition{}
+		for _, t := range workflow[i.Status.Key] {
+			result.Transitions = append(result.Transitions, model.Transition{Self: s.transitionURL(i, t.id), ID: t.id, Display: t.id})
+		}
+	}
+	return result
+}
+
+func (s *Server) transitionURL(i *issue, transitionID string) string {
+	return s.selfURL("/issues/" + i.Key + "/transitions/" + transitionID)
+}
+
+func (s *Server) createIssue(w http.ResponseWriter, r *http.Request) {
+	var req model.IssueCreateRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	created, status, message := s.createIssueLocked(&req)
+	if status != http.StatusCreated {
+		writeError(w, status, message)
+		return
+	}
+	writeJSON(w, http.StatusCreated, created.IssueResponse)
+}
+
+func (s *Server) getIssue(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	found := s.lookupIssue(w, r)
+	if found == nil {
+		return
+	}
+	var expand []string
+	for _, value := range r.URL.Query()["expand"] {
+		expand = append(expand, strings.Split(value, ",")...)
+	}
+	writeJSON(w, http.StatusOK, s.view(found, expand))
+}
+
+func (s *Server) modifyIssue(w http.ResponseWriter, r *http.Request) {
+	var req model.IssueModifyRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	found := s.lookupIssue(w, r)
+	if found == nil {
+		return
+	}
+	if version := r.URL.Query().Get("version"); version != "" && version != strconv.Itoa(found.Version) {
+		writeError(w, http.StatusConflict, fmt.Sprintf("issue %s has version %d", found.Key, found.Version))
+		return
+	}
+	before := found.IssueResponse
+	if req.Summary != "" {
+		found.Summary = req.Summary
+	}
+	if req.Description != "" {
+		found.Description = req.Description
+	}
+	if req.Tags != nil {
+		found.Tags = req.Tags
+	}
+	if issueType := orString(req.Type.Key, req.Type.ID); issueType != "" {
+		found.Type = model.IssueType{
+			ObjectBaseResponse: model.ObjectBaseResponse{Self: s.selfURL("/issuetypes/" + issueType), ID: issueType, Display: issueType},
+			Key:                issueType,
+		}
+	}
+	if key := orString(req.Priority.Key, req.Priority.ID); key != "" {
+		priority := s.findPriority(key)
+		if priority == nil {
+			writeError(w, http.StatusBadRequest, "unknown priority "+key)
+			return
+		}
+		found.Priority = model.IssuePriority{
+			ObjectBaseResponse: model.ObjectBaseResponse{Self: priority.Self, ID: strconv.Itoa(priority.ID), Display: localizedName(priority.Name)},
+			Key:                priority.Key,
+		}
+	}
+	if key := orString(req.Parent.Key, req.Parent.ID); key != "" {
+		parent := s.findIssue(key)
+		if parent == nil {
+			notFound(w, "issue", key)
+			return
+		}
+		found.Parent = model.IssueParent{ObjectBaseResponse: issueRef(parent), Key: parent.Key}
+	}
+	for _, login := range req.Followers.Add {
+		user := s.findUser(login)
+		if user == nil {
+			writeError(w, http.StatusBadRequest, "unknown follower "+login)
+			return
+		}
+		if !slices.ContainsFunc(found.Followers, func(f model.ObjectBaseResponse) bool { return f.ID == strconv.Itoa(user.UID) }) {
+			found.Followers = append(found.Followers, s.userRef(user))
+		}
+	}
+	for _, login := range req.Followers.Remove {
+		if user := s.findUser(login); user != nil {
+			found.Followers = slices.DeleteFunc(found.Followers, func(f model.ObjectBaseResponse) bool { return f.ID == strconv.Itoa(user.UID) })
+		}
+	}
+	for _, id := range slices.Concat(req.AttachmentIds, req.DescriptionAttachmentIds) {
+		s.attachTemporaryFile(found, id)
+	}
+	s.touch(found)
+	s.recordChange(found, model.ChangelogTypeIssueUpdated, before)
+	writeJSON(w, http.StatusOK, found.IssueResponse)
+}
+
+// moveIssue gives the issue a key in the target queue. The old key keeps pointing to the issue.
+// Components are dropped unless moveAllFields is set or they are overridden.
+func (s *Server) moveIssue(w http.ResponseWriter, r *http.Request) {
+	var req model.IssueMoveRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	query := r.URL.Query()
+	queue := query.Get("queue")
+	if queue == "" {
+		writeError(w, http.StatusBadRequest, "queue is required")
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	found := s.lookupIssue(w, r)
+	if found == nil {
+		return
+	}
+	if found.Queue.Key == queue {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("issue %s is already in queue %s", found.Key, queue))
+		return
+	}
+	var components []model.IssueComponent
+	for _, name := range req.Components {
+		component := s.findComponent(name)
+		if component == nil || component.Queue.Key != queue {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("unknown component %s in queue %s", name, queue))
+			return
+		}
+		components = append(components, model.IssueComponent(s.componentRef(component)))
+	}
+	var priority *model.PriorityResponse
+	if key := orString(req.Priority.Key, req.Priority.ID); key != "" {
+		if priority = s.findPriority(key); priority == nil {
+			writeError(w, http.StatusBadRequest, "unknown priority "+key)
+			return
+		}
+	}
+
+	before := found.IssueResponse
+	s.queueCounts[queue]++
+	key := fmt.Sprintf("%s-%d", queue, s.queueCounts[queue])
+	for unique, uniqueKey := range s.uniqueKeys {
+		if uniqueKey == found.Key {
+			s.uniqueKeys[unique] = key
+		}
+	}
+	found.Key = key
+	found.Self = s.selfURL("/issues/" + key)
+	found.Queue = model.IssueQueue{ObjectBaseResponse: model.ObjectBaseResponse{Self: s.selfURL("/queues/" + queue), ID: queue, Display: queue}, Key: queue}
+	s.issueByKey[key] = found
+	if components != nil || query.Get("moveAllFields") != "true" {
+		found.Components = components
+	}
+	if issueType := orString(req.Type.Key, req.Type.ID); issueType != "" {
+		found.Type = model.IssueType{
+			ObjectBaseResponse: model.ObjectBaseResponse{Self: s.selfURL("/issuetypes/" + issueType), ID: issueType, Display: issueType},
+			Key:                issueType,
+		}
+	}
+	if priority != nil {
+		found.Priority = model.IssuePriority{
+			ObjectBaseResponse: model.ObjectBaseResponse{Self: priority.Self, ID: strconv.Itoa(priority.ID), Display: localizedName(priority.Name)},
+			Key:                priority.Key,
+		}
+	}
+	if query.Get("initialStatus") == "true" {
+		s.setStatus(found, "open")
+	}
+	s.touch(found)
+	s.recordChange(found, model.ChangelogTypeIssueMoved, before)
+	writeJSON(w, http.StatusOK, found.IssueResponse)
+}
+
+func (s *Server) getTransitions(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	found := s.lookupIssue(w, r)
+	if found == nil {
+		return
+	}
+	writeJSON(w, http.StatusOK, s.transitions(found))
+}
+
+func (s *Server) transitions(i *issue) []model.IssueTransitionsResponse {
+	result := []model.IssueTransitionsResponse{}
+	for _, t := range workflow[i.Status.Key] {
+		result = append(result, model.IssueTransitionsResponse{
+			ObjectBaseResponse: model.ObjectBaseResponse{Self: s.transitionURL(i, t.id), ID: t.id, Display: t.id},
+			To: model.NextTransition{
+				ObjectBaseResponse: model.ObjectBaseResponse{Self: s.selfURL("/statuses/" + t.to), ID: t.to, Display: statusNames[t.to]},
+				Key:                t.to,
+			},
+		})
+	}
+	return result
+}
+
+func (s *Server) executeTransition(w http.ResponseWriter, r *http.Request) {
+	var req model.IssueModifyStatusRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	found := s.lookupIssue(w, r)
+	if found == nil {
+		return
+	}
+	transitionID := r.PathValue("transition_id")
+	index := slices.IndexFunc(workflow[found.Status.Key], func(t statusTransition) bool { return t.id == transitionID })
+	if index < 0 {
+		writeError(w, http.StatusConflict, fmt.Sprintf("transition %s is not available from status %s", transitionID, found.Status.Key))
+		return
+	}
+	before := found.IssueResponse
+	if req.Assignee != "" {
+		user := s.findUser(req.Assignee)
+		if user == nil {
+			writeError(w, http.StatusBadRequest, "unknown assignee "+req.Assignee)
+			return
+		}
+		found.Assignee = model.IssueAssignee(s.userRef(user))
+	}
+	if req.Comment != "" {
+		s.addComment(found, &model.CommentRequest{Text: req.Comment})
+	}
+	s.setStatus(found, workflow[found.Status.Key][index].to)
+	s.touch(found)
+	s.recordChange(found, model.ChangelogTypeIssueWorkflow, before)
+
+	result := []model.IssueModifyStatusResponse{}
+	for _, t := range s.transitions(found) {
+		result = append(result, model.IssueModifyStatusResponse{Self: t.Self, TransitionID: t.ID, To: t.To})
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+func (s *Server) countIssues(w http.ResponseWriter, r *http.Request) {
+	var req model.IssueCountRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	if req.Query != "" {
+		writeError(w, http.StatusBadRequest, "query language is not supported by trackertest")
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	writeJSON(w, http.StatusOK, len(s.search(&model.IssueSearchRequest{Filter: req.Filter})))
+}
+
+func (s *Server) searchIssues(w http.ResponseWriter, r *http.Request) {
+	var req model.IssueSearchRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	if req.Query != "" {
+		writeError(w, http.StatusBadRequest, "query language is not supported by trackertest")
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	query := r.URL.Query()
+	if query.Has("scrollId") || query.Has("scrollType") {
+		s.searchScroll(w, r, &req)
+		return
+	}
+	found := paginate(w, r, s.search(&req))
+	result := make([]model.IssueResponse, 0, len(found))
+	for _, i := range found {
+		result = append(result, i.IssueResponse)
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+// searchScroll starts a new scroll or answers the next portion of the existing one
+func (s *Server) searchScroll(w http.ResponseWriter, r *http.Request, req *model.IssueSearchRequest) {
+	query := r.URL.Query()
+	scrollID := query.Get("scrollId")
+	current, ok := s.scrolls[scrollID]
+	switch {
+	case scrollID == "":
+		current = &scroll{perScroll: 100, token: fmt.Sprintf("token-%d", s.newID())}
+		if perScroll, err := strconv.Atoi(query.Get("perScroll")); err == nil && perScroll > 0 {
+			current.perScroll = perScroll
+		}
+		for _, i := range s.search(req) {
+			current.keys = append(current.keys, i.Key)
+		}
+		scrollID = fmt.Sprintf("scroll-%d", s.newID())
+		s.scrolls[scrollID] = current
+		w.Header().Set("X-Total-Count", strconv.Itoa(len(current.keys)))
+	case !ok:
+		notFound(w, "scroll", scrollID)
+		return
+	}
+	portion := current.keys[:min(current.perScroll, len(current.keys))]
+	current.keys = current.keys[len(portion):]
+	result := []model.IssueResponse{}
+	for _, key := range portion {
+		if i, ok := s.issueByKey[key]; ok {
+			result = append(result, i.IssueResponse)
+		}
+	}
+	w.Header().Set("X-Scroll-Id", scrollID)
+	w.Header().Set("X-Scroll-Token", current.token)
+	writeJSON(w, http.StatusOK, result)
+}
+
+func (s *Server) clearScroll(w http.ResponseWriter, r *http.Request) {
+	var req map[string]string
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for scrollID := range req {
+		delete(s.scrolls, scrollID)
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// search returns issues matching the request following the priority of its parameters
+func (s *Server) search(req *model.IssueSearchRequest) []*issue {
+	var result []*issue
+	switch {
+	case req.Queue != "":
+		for _, i := range s.issues {
+			if i.Queue.Key == req.Queue {
+				result = append(result, i)
+			}
+		}
+	case len(req.Keys) != 0:
+		for _, key := range req.Keys {
+			if i := s.findIssue(key); i != nil {
+				result = append(result, i)
+			}
+		}
+	default:
+		for _, i := range s.issues {
+			if s.matchesFilter(i, req.Filter) {
+				result = append(result, i)
+			}
+		}
+		if field, desc := strings.CutPrefix(strings.TrimPrefix(req.Order, "+"), "-"); field != "" {
+			slices.SortStableFunc(result, func(a, b *issue) int {
+				order := strings.Compare(firstOf(s.fieldValues(a, field)), firstOf(s.fieldValues(b, field)))
+				if desc {
+					return -order
+				}
+				return order
+			})
+		}
+	}
+	return result
+}
+
+// matchesFilter reports whether every filter field has at least one matching value
+func (s *Server) matchesFilter(i *issue, filter map[string]any) bool {
+	for field, expected := range filter {
+		var wanted []string
+		switch v := expected.(type) {
+		case []any:
+			for _, item := range v {
+				wanted = append(wanted, refKey(item))
+			}
+		default:
+			wanted = append(wanted, refKey(v))
+		}
+		actual := s.fieldValues(i, field)
+		if !slices.ContainsFunc(wanted, func(w string) bool { return slices.Contains(actual, w) }) {
+			return false
+		}
+	}
+	return true
+}
+
+// fieldValues returns values of the issue field usable for filtering and sorting
+func (s *Server) fieldValues(i *issue, field string) []string {
+	switch field {
+	case "key":
+		return []string{i.Key}
+	case "summary":
+		return []string{i.Summary}
+	case "queue":
+		return []string{i.Queue.Key}
+	case "status":
+		return []string{i.Status.Key}
+	case "type":
+		return []string{i.Type.Key}
+	case "priority":
+		return []string{i.Priority.Key, i.Priority.ID}
+	case "assignee":
+		return s.userValues(i.Assignee.ID)
+	case "author", "createdBy":
+		return s.userValues(i.CreatedBy.ID)
+	case "tags":
+		return i.Tags
+	case "components":
+		var names []string
+		for _, c := range i.Components {
+			names = append(names, c.Display, c.ID)
+		}
+		return names
+	case "createdAt", "created":
+		return []string{i.CreatedAt}
+	case "updatedAt", "updated":
+		return []string{i.UpdatedAt}
+	}
+	return nil
+}
+
+func firstOf(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func orString(value, fallback string) string {
+	if value == "" {
+		return fallback
+	}
+	return value
+}
@@ -0,0 +1,154 @@
+// Package trackertest provides an in-memory fake of Yandex Tracker API for tests.
+package trackertest
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+
+	model "github.com/IndianMax03/yandex-tracker-go-client/model"
+)
+
+// linkType is a pair of relationships describing the link from both of its ends
+type linkType struct {
+	id      string
+	outward model.LinkRelationship
+	inward  model.LinkRelationship
+}
+
+var linkTypes = []linkType{
+	{"relates", model.RelationshipRelates, model.RelationshipRelates},
+	{"depends", model.RelationshipDependsOn, model.RelationshipIsDependentBy},
+	{"subtask", model.RelationshipIsSubtaskFor, model.RelationshipIsParentTaskFor},
+	{"duplicates", model.RelationshipDuplicates, model.RelationshipIsDuplicatedBy},
+	{"epic", model.RelationshipIsEpicOf, model.RelationshipHasEpic},
+}
+
+// link is a stored link, it is seen as outward from the source issue and as inward from the target one
+type link struct {
+	id        int
+	typ       linkType
+	source    *issue
+	target    *issue
+	createdAt string
+}
+
+// Links returns the stored links of the issue in order of creation
+func (s *Server) Links(issueID string) []model.IssueLinkResponse {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	found := s.findIssue(issueID)
+	if found == nil {
+		return nil
+	}
+	return s.linkViews(found)
+}
+
+// linkViews returns links of the issue as seen from it
+func (s *Server) linkViews(i *issue) []model.IssueLinkResponse {
+	result := []model.IssueLinkResponse{}
+	for _, l := range s.links {
+		if l.source == i || l.target == i {
+			result = append(result, s.linkView(l, i))
+		}
+	}
+	return result
+}
+
+// linkView returns the link as seen from the issue on one of its ends
+func (s *Server) linkView(l *link, from *issue) model.IssueLinkResponse {
+	other, direction := l.target, model.LinkDirectionOutward
+	if from == l.target {
+		other, direction = l.source, model.LinkDirectionInward
+	}
+	return model.IssueLinkResponse{
+		Self: s.selfURL("/issues/" + from.Key + "/links/" + strconv.Itoa(l.id)),
+		ID:   l.id,
+		Type: model.IssueLinkType{
+			Self:    s.selfURL("/linktypes/" + l.typ.id),
+			ID:      l.typ.id,
+			Inward:  string(l.typ.inward),
+			Outward: string(l.typ.outward),
+		},
+		Direction: direction,
+		Object:    model.LinkedIssue{ObjectBaseResponse: issueRef(other), Key: other.Key},
+		CreatedBy: model.CreatedBy(s.userRef(&s.myself)),
+		UpdatedBy: model.UpdatedBy(s.userRef(&s.myself)),
+		CreatedAt: l.createdAt,
+		UpdatedAt: l.createdAt,
+		Assignee:  other.Assignee,
+		Status:    other.Status,
+	}
+}
+
+func (s *Server) createLink(w http.ResponseWriter, r *http.Request) {
+	var req model.IssueLinkRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	found := s.lookupIssue(w, r)
+	if found == nil {
+		return
+	}
+	other := s.findIssue(req.Issue)
+	if other == nil {
+		writeError(w, http.StatusBadRequest, "unknown issue "+req.Issue)
+		return
+	}
+	if other == found {
+		writeError(w, http.StatusBadRequest, "issue cannot be linked with itself")
+		return
+	}
+	created := &link{id: s.newID(), source: found, target: other, createdAt: now()}
+	index := slices.IndexFunc(linkTypes, func(t linkType) bool { return t.outward == req.Relationship })
+	if index < 0 {
+		index = slices.IndexFunc(linkTypes, func(t linkType) bool { return t.inward == req.Relationship })
+		created.source, created.target = other, found
+	}
+	if index < 0 {
+		writeError(w, http.StatusBadRequest, "unknown relationship "+string(req.Relationship))
+		return
+	}
+	created.typ = linkTypes[index]
+	for _, l := range s.links {
+		if l.source == found && l.target == other || l.source == other && l.target == found {
+			writeError(w, http.StatusConflict, fmt.Sprintf("issues %s and %s are already linked", found.Key, other.Key))
+			return
+		}
+	}
+	s.links = append(s.links, created)
+	writeJSON(w, http.StatusCreated, s.linkView(created, found))
+}
+
+func (s *Server) getLinks(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	found := s.lookupIssue(w, r)
+	if found == nil {
+		return
+	}
+	writeJSON(w, http.StatusOK, s.linkViews(found))
+}
+
+// deleteLink removes the link from both of the linked issues
+func (s *Server) deleteLink(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	found := s.lookupIssue(w, r)
+	if found == nil {
+		return
+	}
+	linkID := r.PathValue("link_id")
+	index := slices.IndexFunc(s.links, func(l *link) bool {
+		return strconv.Itoa(l.id) == linkID && (l.source == found || l.target == found)
+	})
+	if index < 0 {
+		notFound(w, "link", linkID)
+		return
+	}
+	s.links = slices.Delete(s.links, index, index+1)
+	w.WriteHeader(http.StatusNoContent)
+}
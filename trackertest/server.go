@@ -0,0 +1,372 @@
+// Package trackertest provides an in-memory fake of Yandex Tracker API for tests.
+//
+//	srv := trackertest.NewServer()
+//	defer srv.Close()
+//	c, err := srv.Client()
+//	issue, err := c.CreateIssue(&model.IssueCreateRequest{Summary: "Test", Queue: model.Queue{Key: "TEST"}})
+package trackertest
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	client "github.com/IndianMax03/yandex-tracker-go-client"
+	model "github.com/IndianMax03/yandex-tracker-go-client/model"
+)
+
+const (
+	defaultPerPage = 50
+	timeLayout     = model.TimeLayout
+)
+
+// Fault describes failure injected into matching requests
+type Fault struct {
+	// HTTP method to match. Empty value matches any method.
+	Method string
+	// Prefix of the resource path without API version to match, e.g. /issues/. Empty value matches any path.
+	PathPrefix string
+	// Delay before the request is handled.
+	Latency time.Duration
+	// Status code answered instead of handling the request, e.g. 429 or 503. Zero value only adds latency.
+	StatusCode int
+	// Value of Retry-After header sent with the status code.
+	RetryAfter string
+	// Number of requests the fault applies to. Zero value applies it to every matching request.
+	Times int
+}
+
+// RecordedRequest describes a request received by the server
+type RecordedRequest struct {
+	// HTTP method.
+	Method string
+	// Resource path without API version, e.g. /issues/TEST-1.
+	Path string
+	// Raw query string.
+	Query string
+}
+
+// Server is an in-memory fake of Yandex Tracker API. It is safe for concurrent use.
+type Server struct {
+	// URL of the server without API version, suitable for client.WithBaseURL.
+	// The server uses TLS with a self-signed certificate trusted by HTTPClient.
+	URL string
+
+	httpServer *httptest.Server
+
+	mu       sync.Mutex
+	faults   []*Fault
+	requests []RecordedRequest
+	nextID   int
+
+	myself     model.UserResponse
+	users      []*model.UserResponse
+	priorities []*model.PriorityResponse
+	components []*model.ComponentResponse
+
+	issues      []*issue
+	issueByKey  map[string]*issue
+	uniqueKeys  map[string]string
+	queueCounts map[string]int
+	links       []*link
+	worklogs    []*model.WorklogResponse
+
+	temporaryFiles map[string]*attachment
+	scrolls        map[string]*scroll
+}
+
+// NewServer starts the server seeded with the current user and default priorities.
+// The server must be closed after use.
+func NewServer() *Server {
+	s := &Server{
+		issueByKey:     map[string]*issue{},
+		uniqueKeys:     map[string]string{},
+		queueCounts:    map[string]int{},
+		temporaryFiles: map[string]*attachment{},
+		scrolls:        map[string]*scroll{},
+	}
+	s.httpServer = httptest.NewTLSServer(s.routes())
+	s.URL = s.httpServer.URL
+	s.myself = *s.addUser(model.UserResponse{Login: "test-user", FirstName: "Test", LastName: "User"})
+	for _, p := range []struct {
+		key    string
+		nameRu string
+		nameEn string
+	}{
+		{"trivial", "Незначительный", "Trivial"},
+		{"minor", "Низкий", "Minor"},
+		{"normal", "Средний", "Normal"},
+		{"critical", "Критичный", "Critical"},
+		{"blocker", "Блокер", "Blocker"},
+	} {
+		id := len(s.priorities) + 1
+		s.priorities = append(s.priorities, &model.PriorityResponse{
+			Self:    s.selfURL("/priorities/" + strconv.Itoa(id)),
+			ID:      id,
+			Key:     p.key,
+			Version: 1,
+			Name:    map[string]string{"ru": p.nameRu, "en": p.nameEn},
+			Order:   id,
+		})
+	}
+	return s
+}
+
+// Close shuts the server down
+func (s *Server) Close() {
+	s.httpServer.Close()
+}
+
+// HTTPClient returns *http.Client that trusts the server certificate
+func (s *Server) HTTPClient() *http.Client {
+	return s.httpServer.Client()
+}
+
+// Client instantiates client.Client pointed at the server. Options are applied after the default ones.
+func (s *Server) Client(opts ...client.Option) (*client.Client, error) {
+	defaults := []client.Option{
+		client.WithOAuthToken("trackertest-token"),
+		client.WithOrgID("trackertest-org"),
+		client.WithBaseURL(s.URL),
+		client.WithHTTPClient(s.HTTPClient()),
+	}
+	return client.NewWithOptions(append(defaults, opts...)...)
+}
+
+// InjectFault adds the fault. Faults are checked in the order they were added.
+func (s *Server) InjectFault(fault Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = append(s.faults, &fault)
+}
+
+// ClearFaults removes all of the injected faults
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = nil
+}
+
+// Requests returns requests received by the server in order
+func (s *Server) Requests() []RecordedRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]RecordedRequest{}, s.requests...)
+}
+
+// serve records the request, applies faults and dispatches it to the API handlers
+func (s *Server) serve(mux *http.ServeMux) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := r.URL.Path
+		if version, rest, ok := strings.Cut(strings.TrimPrefix(path, "/"), "/"); ok && (version == "v2" || version == "v3") {
+			path = "/" + rest
+		}
+		fault := s.record(r.Method, path, r.URL.RawQuery)
+		if fault != nil {
+			time.Sleep(fault.Latency)
+			if fault.StatusCode != 0 {
+				if fault.RetryAfter != "" {
+					w.Header().Set("Retry-After", fault.RetryAfter)
+				}
+				writeError(w, fault.StatusCode, "injected fault")
+				return
+			}
+		}
+		if r.Header.Get("Authorization") == "" {
+			writeError(w, http.StatusUnauthorized, "authorization required")
+			return
+		}
+		if r.Header.Get("X-Org-ID") == "" && r.Header.Get("X-Cloud-Org-ID") == "" {
+			writeError(w, http.StatusBadRequest, "X-Org-ID or X-Cloud-Org-ID header required")
+			return
+		}
+		r.URL.Path = path
+		mux.ServeHTTP(w, r)
+	})
+}
+
+// record stores the request and returns the first matching fault
+func (s *Server) record(method, path, query string) *Fault {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = append(s.requests, RecordedRequest{Method: method, Path: path, Query: query})
+	for i, fault := range s.faults {
+		if fault.Method != "" && fault.Method != method || !strings.HasPrefix(path, fault.PathPrefix) {
+			continue
+		}
+		if fault.Times > 0 {
+			fault.Times--
+			if fault.Times == 0 {
+				s.faults = append(s.faults[:i:i], s.faults[i+1:]...)
+			}
+		}
+		result := *fault
+		return &result
+	}
+	return nil
+}
+
+func (s *Server) routes() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("POST /issues/{$}", s.createIssue)
+	mux.HandleFunc("POST /issues/_count", s.countIssues)
+	mux.HandleFunc("POST /issues/_search", s.searchIssues)
+	mux.HandleFunc("GET /issues/{issue_id}", s.getIssue)
+	mux.HandleFunc("PATCH /issues/{issue_id}", s.modifyIssue)
+	mux.HandleFunc("GET /issues/{issue_id}/transitions", s.getTransitions)
+	mux.HandleFunc("GET /issues/{issue_id}/changelog", s.getChangelog)
+	mux.HandleFunc("POST /issues/{issue_id}/_move", s.moveIssue)
+	mux.HandleFunc("POST /issues/{issue_id}/transitions/{transition_id}/_execute", s.executeTransition)
+	mux.HandleFunc("POST /system/search/scroll/_clear", s.clearScroll)
+
+	mux.HandleFunc("POST /issues/{issue_id}/comments", s.createComment)
+	mux.HandleFunc("GET /issues/{issue_id}/comments", s.getComments)
+	mux.HandleFunc("GET /issues/{issue_id}/comments/{comment_id}", s.getComment)
+	mux.HandleFunc("PATCH /issues/{issue_id}/comments/{comment_id}", s.updateComment)
+	mux.HandleFunc("DELETE /issues/{issue_id}/comments/{comment_id}", s.deleteComment)
+
+	mux.HandleFunc("GET /issues/{issue_id}/attachments", s.getAttachments)
+	mux.HandleFunc("POST /issues/{issue_id}/attachments", s.attachFile)
+	mux.HandleFunc("GET /issues/{issue_id}/attachments/{attachment_id}", s.getAttachment)
+	mux.HandleFunc("GET /issues/{issue_id}/attachments/{attachment_id}/{name}", s.downloadAttachment)
+	mux.HandleFunc("DELETE /issues/{issue_id}/attachments/{attachment_id}", s.deleteAttachment)
+	mux.HandleFunc("POST /attachments/{$}", s.uploadTemporaryAttachment)
+
+	mux.HandleFunc("POST /issues/{issue_id}/links", s.createLink)
+	mux.HandleFunc("GET /issues/{issue_id}/links", s.getLinks)
+	mux.HandleFunc("DELETE /issues/{issue_id}/links/{link_id}", s.deleteLink)
+
+	mux.HandleFunc("POST /issues/{issue_id}/worklog", s.createWorklog)
+	mux.HandleFunc("GET /issues/{issue_id}/worklog", s.getWorklogs)
+	mux.HandleFunc("PATCH /issues/{issue_id}/worklog/{worklog_id}", s.updateWorklog)
+	mux.HandleFunc("DELETE /issues/{issue_id}/worklog/{worklog_id}", s.deleteWorklog)
+	mux.HandleFunc("POST /worklog/_search", s.searchWorklogs)
+
+	mux.HandleFunc("GET /issues/{issue_id}/checklistItems", s.getChecklist)
+	mux.HandleFunc("POST /issues/{issue_id}/checklistItems", s.addChecklistItem)
+	mux.HandleFunc("PATCH /issues/{issue_id}/checklistItems/{item_id}", s.editChecklistItem)
+	mux.HandleFunc("POST /issues/{issue_id}/checklistItems/{item_id}/_move", s.moveChecklistItem)
+	mux.HandleFunc("DELETE /issues/{issue_id}/checklistItems/{item_id}", s.deleteChecklistItem)
+
+	mux.HandleFunc("GET /priorities/{$}", s.getPriorities)
+	mux.HandleFunc("GET /priorities/{priority_id}", s.getPriority)
+	mux.HandleFunc("GET /myself", s.getMyself)
+	mux.HandleFunc("GET /users/{$}", s.getUsers)
+	mux.HandleFunc("GET /users/{login_or_user_id}", s.getUser)
+	mux.HandleFunc("POST /components/{$}", s.createComponent)
+	mux.HandleFunc("GET /components/{$}", s.getComponents)
+	mux.HandleFunc("GET /components/{component_id}", s.getComponent)
+	mux.HandleFunc("PATCH /components/{component_id}", s.updateComponent)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotFound, "unknown resource "+r.Method+" "+r.URL.Path)
+	})
+	return s.serve(mux)
+}
+
+// newID returns unique sequential identifier
+func (s *Server) newID() int {
+	s.nextID++
+	return s.nextID
+}
+
+func (s *Server) selfURL(path string) string {
+	return s.URL + "/v2" + path
+}
+
+func now() string {
+	return time.Now().Format(timeLayout)
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// writeCacheableJSON answers with ETag of the body and 304 if it matches If-None-Match
+func writeCacheableJSON(w http.ResponseWriter, r *http.Request, body any) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	etag := fmt.Sprintf(`"%x"`, sha256.Sum256(data))
+	w.Header().Set("ETag", etag)
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]any{
+		"errors":        map[string]string{},
+		"errorMessages": []string{message},
+		"statusCode":    status,
+	})
+}
+
+func decodeBody(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return false
+	}
+	return true
+}
+
+// pageParams parses page and perPage query parameters
+func pageParams(r *http.Request) (page, perPage int) {
+	page, _ = strconv.Atoi(r.URL.Query().Get("page"))
+	perPage, _ = strconv.Atoi(r.URL.Query().Get("perPage"))
+	return max(page, 1), orDefault(perPage, defaultPerPage)
+}
+
+func orDefault(value, fallback int) int {
+	if value <= 0 {
+		return fallback
+	}
+	return value
+}
+
+// paginate cuts the page out of items and sets X-Total-Pages and X-Total-Count headers
+func paginate[T any](w http.ResponseWriter, r *http.Request, items []T) []T {
+	page, perPage := pageParams(r)
+	totalPages := int(math.Ceil(float64(len(items)) / float64(perPage)))
+	w.Header().Set("X-Total-Pages", strconv.Itoa(totalPages))
+	w.Header().Set("X-Total-Count", strconv.Itoa(len(items)))
+	start := min((page-1)*perPage, len(items))
+	end := min(start+perPage, len(items))
+	return items[start:end]
+}
+
+// refKey extracts key, id or login from a reference given as a string, a number or an object
+func refKey(ref any) string {
+	switch v := ref.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case map[string]any:
+		for _, field := range []string{"key", "id", "login", "name"} {
+			if value, ok := v[field]; ok {
+				return refKey(value)
+			}
+		}
+	}
+	return ""
+}
+
+func notFound(w http.ResponseWriter, what, id string) {
+	writeError(w, http.StatusNotFound, fmt.Sprintf("%s %s does not exist", what, id))
+}
@@ -0,0 +1,157 @@
+// Package trackertest provides an in-memory fake of Yandex Tracker API for tests.
+package trackertest
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	model "github.com/IndianMax03/yandex-tracker-go-client/model"
+)
+
+// Worklogs returns the stored records of time spent on the issue in order of creation
+func (s *Server) Worklogs(issueID string) []model.WorklogResponse {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	found := s.findIssue(issueID)
+	if found == nil {
+		return nil
+	}
+	return s.issueWorklogs(found)
+}
+
+func (s *Server) issueWorklogs(i *issue) []model.WorklogResponse {
+	result := []model.WorklogResponse{}
+	for _, worklog := range s.worklogs {
+		if worklog.Issue.ID == i.ID {
+			result = append(result, *worklog)
+		}
+	}
+	return result
+}
+
+// lookupWorklog writes 404 and returns -1 if the record from the path does not exist or belongs to another issue
+func (s *Server) lookupWorklog(w http.ResponseWriter, r *http.Request, i *issue) int {
+	worklogID := r.PathValue("worklog_id")
+	index := slices.IndexFunc(s.worklogs, func(worklog *model.WorklogResponse) bool {
+		return strconv.Itoa(worklog.ID) == worklogID && worklog.Issue.ID == i.ID
+	})
+	if index < 0 {
+		notFound(w, "worklog", worklogID)
+	}
+	return index
+}
+
+func (s *Server) createWorklog(w http.ResponseWriter, r *http.Request) {
+	var req model.WorklogRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	if req.Start.IsZero() || req.Duration <= 0 {
+		writeError(w, http.StatusBadRequest, "start and duration are required")
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	found := s.lookupIssue(w, r)
+	if found == nil {
+		return
+	}
+	id := s.newID()
+	createdAt := time.Now()
+	worklog := &model.WorklogResponse{
+		Self:      s.selfURL("/issues/" + found.Key + "/worklog/" + strconv.Itoa(id)),
+		ID:        id,
+		Version:   1,
+		Issue:     model.WorklogIssue{ObjectBaseResponse: issueRef(found), Key: found.Key},
+		Comment:   req.Comment,
+		CreatedBy: model.CreatedBy(s.userRef(&s.myself)),
+		UpdatedBy: model.UpdatedBy(s.userRef(&s.myself)),
+		CreatedAt: createdAt,
+		UpdatedAt: createdAt,
+		Start:     req.Start,
+		Duration:  req.Duration,
+	}
+	s.worklogs = append(s.worklogs, worklog)
+	writeJSON(w, http.StatusCreated, worklog)
+}
+
+func (s *Server) getWorklogs(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	found := s.lookupIssue(w, r)
+	if found == nil {
+		return
+	}
+	writeJSON(w, http.StatusOK, s.issueWorklogs(found))
+}
+
+func (s *Server) updateWorklog(w http.ResponseWriter, r *http.Request) {
+	var req model.WorklogUpdateRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	found := s.lookupIssue(w, r)
+	if found == nil {
+		return
+	}
+	index := s.lookupWorklog(w, r, found)
+	if index < 0 {
+		return
+	}
+	worklog := s.worklogs[index]
+	if req.Duration > 0 {
+		worklog.Duration = req.Duration
+	}
+	if req.Comment != "" {
+		worklog.Comment = req.Comment
+	}
+	worklog.Version++
+	worklog.UpdatedAt = time.Now()
+	worklog.UpdatedBy = model.UpdatedBy(s.userRef(&s.myself))
+	writeJSON(w, http.StatusOK, worklog)
+}
+
+func (s *Server) deleteWorklog(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	found := s.lookupIssue(w, r)
+	if found == nil {
+		return
+	}
+	index := s.lookupWorklog(w, r, found)
+	if index < 0 {
+		return
+	}
+	s.worklogs = slices.Delete(s.worklogs, index, index+1)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// searchWorklogs answers records matching the author and the creation date range
+func (s *Server) searchWorklogs(w http.ResponseWriter, r *http.Request) {
+	var req model.WorklogSearchRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var matched []*model.WorklogResponse
+	for _, worklog := range s.worklogs {
+		if req.CreatedBy != "" && !slices.Contains(s.userValues(worklog.CreatedBy.ID), req.CreatedBy) {
+			continue
+		}
+		if !req.From.IsZero() && worklog.CreatedAt.Before(req.From) || !req.To.IsZero() && worklog.CreatedAt.After(req.To) {
+			continue
+		}
+		matched = append(matched, worklog)
+	}
+	page := paginate(w, r, matched)
+	result := make([]model.WorklogResponse, 0, len(page))
+	for _, worklog := range page {
+		result = append(result, *worklog)
+	}
+	writeJSON(w, http.StatusOK, result)
+}
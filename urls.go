@@ -18,6 +18,24 @@ var issueGetAttachmentsURL = issuesBaseURL + "{issue_id}/attachments"
 var issueGetAttachmentURL = issuesBaseURL + "{issue_id}/attachments/{attachment_id}"
 var issueAttachFileURL = issuesBaseURL + "{issue_id}/attachments"
 var issueDeleteFileURL = issuesBaseURL + "{issue_id}/attachments/{file_id}"
+var issueCreateLinkURL = issuesBaseURL + "{issue_id}/links"
+var issueGetLinksURL = issuesBaseURL + "{issue_id}/links"
+var issueDeleteLinkURL = issuesBaseURL + "{issue_id}/links/{link_id}"
+var issueCreateWorklogURL = issuesBaseURL + "{issue_id}/worklog"
+var issueGetWorklogsURL = issuesBaseURL + "{issue_id}/worklog"
+var issueUpdateWorklogURL = issuesBaseURL + "{issue_id}/worklog/{worklog_id}"
+var issueDeleteWorklogURL = issuesBaseURL + "{issue_id}/worklog/{worklog_id}"
+var issueGetChecklistURL = issuesBaseURL + "{issue_id}/checklistItems"
+var issueAddChecklistItemURL = issuesBaseURL + "{issue_id}/checklistItems"
+var issueEditChecklistItemURL = issuesBaseURL + "{issue_id}/checklistItems/{item_id}"
+var issueMoveChecklistItemURL = issuesBaseURL + "{issue_id}/checklistItems/{item_id}/_move"
+var issueDeleteChecklistItemURL = issuesBaseURL + "{issue_id}/checklistItems/{item_id}"
+var issueGetChangelogURL = issuesBaseURL + "{issue_id}/changelog"
+var issueMoveURL = issuesBaseURL + "{issue_id}/_move"
+
+var worklogSearchURL = "/worklog/_search"
+
+var scrollClearURL = "/system/search/scroll/_clear"
 
 var attachmentsBase = "/attachments/"
 var attachmentUploadURL = attachmentsBase